@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -86,6 +87,10 @@ type ListInfraApplyReq struct {
 	PageIdx              int32    `protobuf:"varint,1,opt,name=pageIdx,proto3" json:"pageIdx,omitempty"`
 	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 	Search               string   `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	ExtendsID            int32    `protobuf:"varint,4,opt,name=extendsID,proto3" json:"extendsID,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Applyer              string   `protobuf:"bytes,6,opt,name=applyer,proto3" json:"applyer,omitempty"`
+	DeviceCode           string   `protobuf:"bytes,7,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -137,6 +142,34 @@ func (m *ListInfraApplyReq) GetSearch() string {
 	return ""
 }
 
+func (m *ListInfraApplyReq) GetExtendsID() int32 {
+	if m != nil {
+		return m.ExtendsID
+	}
+	return 0
+}
+
+func (m *ListInfraApplyReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListInfraApplyReq) GetApplyer() string {
+	if m != nil {
+		return m.Applyer
+	}
+	return ""
+}
+
+func (m *ListInfraApplyReq) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
 type ListInfraApplyReply struct {
 	Page                 *ModelPage               `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	Record               []*DetailInfraApplyReply `protobuf:"bytes,2,rep,name=record,proto3" json:"record,omitempty"`
@@ -193,17 +226,23 @@ func (m *ListInfraApplyReply) GetExhausted() bool {
 }
 
 type DetailInfraApplyReply struct {
-	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	DeviceCode           string   `protobuf:"bytes,2,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
-	Applyer              string   `protobuf:"bytes,3,opt,name=applyer,proto3" json:"applyer,omitempty"`
-	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	SubjectName          string   `protobuf:"bytes,5,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
-	ReviewId             string   `protobuf:"bytes,6,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
-	ExpireTM             string   `protobuf:"bytes,7,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
-	ReviewTM             string   `protobuf:"bytes,8,opt,name=reviewTM,proto3" json:"reviewTM,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ID                   int32         `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	DeviceCode           string        `protobuf:"bytes,2,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
+	Applyer              string        `protobuf:"bytes,3,opt,name=applyer,proto3" json:"applyer,omitempty"`
+	Status               string        `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SubjectName          string        `protobuf:"bytes,5,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
+	ReviewId             string        `protobuf:"bytes,6,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	ExpireTM             string        `protobuf:"bytes,7,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+	ReviewTM             string        `protobuf:"bytes,8,opt,name=reviewTM,proto3" json:"reviewTM,omitempty"`
+	Comment              string        `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	WorkflowID           int32         `protobuf:"varint,10,opt,name=workflowID,proto3" json:"workflowID,omitempty"`
+	Stage                int32         `protobuf:"varint,11,opt,name=stage,proto3" json:"stage,omitempty"`
+	PolicyRule           string        `protobuf:"bytes,12,opt,name=policyRule,proto3" json:"policyRule,omitempty"`
+	Device               *DeviceDetail `protobuf:"bytes,13,opt,name=device,proto3" json:"device,omitempty"`
+	ExtendsID            int32         `protobuf:"varint,14,opt,name=extendsID,proto3" json:"extendsID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *DetailInfraApplyReply) Reset()         { *m = DetailInfraApplyReply{} }
@@ -287,539 +326,4790 @@ func (m *DetailInfraApplyReply) GetReviewTM() string {
 	return ""
 }
 
-// Add
-type AddInfraApplyReq struct {
-	DeviceCode           string   `protobuf:"bytes,1,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
-	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	SubjectName          string   `protobuf:"bytes,3,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
-	ExpireTM             string   `protobuf:"bytes,4,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+func (m *DetailInfraApplyReply) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *DetailInfraApplyReply) GetWorkflowID() int32 {
+	if m != nil {
+		return m.WorkflowID
+	}
+	return 0
+}
+
+func (m *DetailInfraApplyReply) GetStage() int32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
+}
+
+func (m *DetailInfraApplyReply) GetPolicyRule() string {
+	if m != nil {
+		return m.PolicyRule
+	}
+	return ""
+}
+
+func (m *DetailInfraApplyReply) GetDevice() *DeviceDetail {
+	if m != nil {
+		return m.Device
+	}
+	return nil
+}
+
+func (m *DetailInfraApplyReply) GetExtendsID() int32 {
+	if m != nil {
+		return m.ExtendsID
+	}
+	return 0
+}
+
+// Search
+type SearchInfraApplyReq struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageIdx              int32    `protobuf:"varint,2,opt,name=pageIdx,proto3" json:"pageIdx,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Applyer              string   `protobuf:"bytes,5,opt,name=applyer,proto3" json:"applyer,omitempty"`
+	DeviceCode           string   `protobuf:"bytes,6,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddInfraApplyReq) Reset()         { *m = AddInfraApplyReq{} }
-func (m *AddInfraApplyReq) String() string { return proto.CompactTextString(m) }
-func (*AddInfraApplyReq) ProtoMessage()    {}
-func (*AddInfraApplyReq) Descriptor() ([]byte, []int) {
+func (m *SearchInfraApplyReq) Reset()         { *m = SearchInfraApplyReq{} }
+func (m *SearchInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*SearchInfraApplyReq) ProtoMessage()    {}
+func (*SearchInfraApplyReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1659b64737a352e6, []int{4}
 }
 
-func (m *AddInfraApplyReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddInfraApplyReq.Unmarshal(m, b)
+func (m *SearchInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchInfraApplyReq.Unmarshal(m, b)
 }
-func (m *AddInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddInfraApplyReq.Marshal(b, m, deterministic)
+func (m *SearchInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchInfraApplyReq.Marshal(b, m, deterministic)
 }
-func (m *AddInfraApplyReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddInfraApplyReq.Merge(m, src)
+func (m *SearchInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchInfraApplyReq.Merge(m, src)
 }
-func (m *AddInfraApplyReq) XXX_Size() int {
-	return xxx_messageInfo_AddInfraApplyReq.Size(m)
+func (m *SearchInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_SearchInfraApplyReq.Size(m)
 }
-func (m *AddInfraApplyReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddInfraApplyReq.DiscardUnknown(m)
+func (m *SearchInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchInfraApplyReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddInfraApplyReq proto.InternalMessageInfo
+var xxx_messageInfo_SearchInfraApplyReq proto.InternalMessageInfo
 
-func (m *AddInfraApplyReq) GetDeviceCode() string {
+func (m *SearchInfraApplyReq) GetQuery() string {
 	if m != nil {
-		return m.DeviceCode
+		return m.Query
 	}
 	return ""
 }
 
-func (m *AddInfraApplyReq) GetUid() string {
+func (m *SearchInfraApplyReq) GetPageIdx() int32 {
 	if m != nil {
-		return m.Uid
+		return m.PageIdx
+	}
+	return 0
+}
+
+func (m *SearchInfraApplyReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchInfraApplyReq) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
 	return ""
 }
 
-func (m *AddInfraApplyReq) GetSubjectName() string {
+func (m *SearchInfraApplyReq) GetApplyer() string {
 	if m != nil {
-		return m.SubjectName
+		return m.Applyer
 	}
 	return ""
 }
 
-func (m *AddInfraApplyReq) GetExpireTM() string {
+func (m *SearchInfraApplyReq) GetDeviceCode() string {
 	if m != nil {
-		return m.ExpireTM
+		return m.DeviceCode
 	}
 	return ""
 }
 
-type AddInfraApplyReply struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SearchInfraApplyHit struct {
+	Apply                *DetailInfraApplyReply `protobuf:"bytes,1,opt,name=apply,proto3" json:"apply,omitempty"`
+	Score                float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights           map[string]string      `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *AddInfraApplyReply) Reset()         { *m = AddInfraApplyReply{} }
-func (m *AddInfraApplyReply) String() string { return proto.CompactTextString(m) }
-func (*AddInfraApplyReply) ProtoMessage()    {}
-func (*AddInfraApplyReply) Descriptor() ([]byte, []int) {
+func (m *SearchInfraApplyHit) Reset()         { *m = SearchInfraApplyHit{} }
+func (m *SearchInfraApplyHit) String() string { return proto.CompactTextString(m) }
+func (*SearchInfraApplyHit) ProtoMessage()    {}
+func (*SearchInfraApplyHit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1659b64737a352e6, []int{5}
 }
 
-func (m *AddInfraApplyReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddInfraApplyReply.Unmarshal(m, b)
+func (m *SearchInfraApplyHit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchInfraApplyHit.Unmarshal(m, b)
 }
-func (m *AddInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddInfraApplyReply.Marshal(b, m, deterministic)
+func (m *SearchInfraApplyHit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchInfraApplyHit.Marshal(b, m, deterministic)
 }
-func (m *AddInfraApplyReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddInfraApplyReply.Merge(m, src)
+func (m *SearchInfraApplyHit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchInfraApplyHit.Merge(m, src)
 }
-func (m *AddInfraApplyReply) XXX_Size() int {
-	return xxx_messageInfo_AddInfraApplyReply.Size(m)
+func (m *SearchInfraApplyHit) XXX_Size() int {
+	return xxx_messageInfo_SearchInfraApplyHit.Size(m)
 }
-func (m *AddInfraApplyReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddInfraApplyReply.DiscardUnknown(m)
+func (m *SearchInfraApplyHit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchInfraApplyHit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddInfraApplyReply proto.InternalMessageInfo
+var xxx_messageInfo_SearchInfraApplyHit proto.InternalMessageInfo
 
-func (m *AddInfraApplyReply) GetResult() string {
+func (m *SearchInfraApplyHit) GetApply() *DetailInfraApplyReply {
 	if m != nil {
-		return m.Result
+		return m.Apply
 	}
-	return ""
+	return nil
 }
 
-// Update
-type UpdateInfraApplyReq struct {
-	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	ExpireTM             string   `protobuf:"bytes,3,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SearchInfraApplyHit) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
 }
 
-func (m *UpdateInfraApplyReq) Reset()         { *m = UpdateInfraApplyReq{} }
-func (m *UpdateInfraApplyReq) String() string { return proto.CompactTextString(m) }
-func (*UpdateInfraApplyReq) ProtoMessage()    {}
-func (*UpdateInfraApplyReq) Descriptor() ([]byte, []int) {
+func (m *SearchInfraApplyHit) GetHighlights() map[string]string {
+	if m != nil {
+		return m.Highlights
+	}
+	return nil
+}
+
+type SearchInfraApplyReply struct {
+	Page                 *ModelPage             `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Hit                  []*SearchInfraApplyHit `protobuf:"bytes,2,rep,name=hit,proto3" json:"hit,omitempty"`
+	Exhausted            bool                   `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SearchInfraApplyReply) Reset()         { *m = SearchInfraApplyReply{} }
+func (m *SearchInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*SearchInfraApplyReply) ProtoMessage()    {}
+func (*SearchInfraApplyReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1659b64737a352e6, []int{6}
 }
 
-func (m *UpdateInfraApplyReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateInfraApplyReq.Unmarshal(m, b)
+func (m *SearchInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchInfraApplyReply.Unmarshal(m, b)
 }
-func (m *UpdateInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateInfraApplyReq.Marshal(b, m, deterministic)
+func (m *SearchInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchInfraApplyReply.Marshal(b, m, deterministic)
 }
-func (m *UpdateInfraApplyReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateInfraApplyReq.Merge(m, src)
+func (m *SearchInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchInfraApplyReply.Merge(m, src)
 }
-func (m *UpdateInfraApplyReq) XXX_Size() int {
-	return xxx_messageInfo_UpdateInfraApplyReq.Size(m)
+func (m *SearchInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_SearchInfraApplyReply.Size(m)
 }
-func (m *UpdateInfraApplyReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateInfraApplyReq.DiscardUnknown(m)
+func (m *SearchInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchInfraApplyReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateInfraApplyReq proto.InternalMessageInfo
+var xxx_messageInfo_SearchInfraApplyReply proto.InternalMessageInfo
 
-func (m *UpdateInfraApplyReq) GetID() int32 {
+func (m *SearchInfraApplyReply) GetPage() *ModelPage {
 	if m != nil {
-		return m.ID
+		return m.Page
 	}
-	return 0
+	return nil
 }
 
-func (m *UpdateInfraApplyReq) GetStatus() string {
+func (m *SearchInfraApplyReply) GetHit() []*SearchInfraApplyHit {
 	if m != nil {
-		return m.Status
+		return m.Hit
 	}
-	return ""
+	return nil
 }
 
-func (m *UpdateInfraApplyReq) GetExpireTM() string {
+func (m *SearchInfraApplyReply) GetExhausted() bool {
 	if m != nil {
-		return m.ExpireTM
+		return m.Exhausted
 	}
-	return ""
+	return false
 }
 
-type UpdateInfraApplyReply struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+// Stats
+type GetInfraApplyStatsReq struct {
+	GroupBy              []string `protobuf:"bytes,1,rep,name=groupBy,proto3" json:"groupBy,omitempty"`
+	Bucket               string   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	FromDay              string   `protobuf:"bytes,3,opt,name=fromDay,proto3" json:"fromDay,omitempty"`
+	ToDay                string   `protobuf:"bytes,4,opt,name=toDay,proto3" json:"toDay,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	SubjectName          string   `protobuf:"bytes,6,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
+	Applyer              string   `protobuf:"bytes,7,opt,name=applyer,proto3" json:"applyer,omitempty"`
+	Reviewer             string   `protobuf:"bytes,8,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Limit                int32    `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateInfraApplyReply) Reset()         { *m = UpdateInfraApplyReply{} }
-func (m *UpdateInfraApplyReply) String() string { return proto.CompactTextString(m) }
-func (*UpdateInfraApplyReply) ProtoMessage()    {}
-func (*UpdateInfraApplyReply) Descriptor() ([]byte, []int) {
+func (m *GetInfraApplyStatsReq) Reset()         { *m = GetInfraApplyStatsReq{} }
+func (m *GetInfraApplyStatsReq) String() string { return proto.CompactTextString(m) }
+func (*GetInfraApplyStatsReq) ProtoMessage()    {}
+func (*GetInfraApplyStatsReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1659b64737a352e6, []int{7}
 }
 
-func (m *UpdateInfraApplyReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateInfraApplyReply.Unmarshal(m, b)
+func (m *GetInfraApplyStatsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfraApplyStatsReq.Unmarshal(m, b)
 }
-func (m *UpdateInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateInfraApplyReply.Marshal(b, m, deterministic)
+func (m *GetInfraApplyStatsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfraApplyStatsReq.Marshal(b, m, deterministic)
 }
-func (m *UpdateInfraApplyReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateInfraApplyReply.Merge(m, src)
+func (m *GetInfraApplyStatsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfraApplyStatsReq.Merge(m, src)
 }
-func (m *UpdateInfraApplyReply) XXX_Size() int {
-	return xxx_messageInfo_UpdateInfraApplyReply.Size(m)
+func (m *GetInfraApplyStatsReq) XXX_Size() int {
+	return xxx_messageInfo_GetInfraApplyStatsReq.Size(m)
 }
-func (m *UpdateInfraApplyReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateInfraApplyReply.DiscardUnknown(m)
+func (m *GetInfraApplyStatsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfraApplyStatsReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateInfraApplyReply proto.InternalMessageInfo
+var xxx_messageInfo_GetInfraApplyStatsReq proto.InternalMessageInfo
 
-func (m *UpdateInfraApplyReply) GetResult() string {
+func (m *GetInfraApplyStatsReq) GetGroupBy() []string {
 	if m != nil {
-		return m.Result
+		return m.GroupBy
 	}
-	return ""
+	return nil
 }
 
-// Delete
-type DelInfraApplyReq struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetInfraApplyStatsReq) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
 }
 
-func (m *DelInfraApplyReq) Reset()         { *m = DelInfraApplyReq{} }
-func (m *DelInfraApplyReq) String() string { return proto.CompactTextString(m) }
-func (*DelInfraApplyReq) ProtoMessage()    {}
-func (*DelInfraApplyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1659b64737a352e6, []int{8}
+func (m *GetInfraApplyStatsReq) GetFromDay() string {
+	if m != nil {
+		return m.FromDay
+	}
+	return ""
 }
 
-func (m *DelInfraApplyReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DelInfraApplyReq.Unmarshal(m, b)
-}
-func (m *DelInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DelInfraApplyReq.Marshal(b, m, deterministic)
-}
-func (m *DelInfraApplyReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelInfraApplyReq.Merge(m, src)
-}
-func (m *DelInfraApplyReq) XXX_Size() int {
-	return xxx_messageInfo_DelInfraApplyReq.Size(m)
+func (m *GetInfraApplyStatsReq) GetToDay() string {
+	if m != nil {
+		return m.ToDay
+	}
+	return ""
 }
-func (m *DelInfraApplyReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelInfraApplyReq.DiscardUnknown(m)
+
+func (m *GetInfraApplyStatsReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-var xxx_messageInfo_DelInfraApplyReq proto.InternalMessageInfo
+func (m *GetInfraApplyStatsReq) GetSubjectName() string {
+	if m != nil {
+		return m.SubjectName
+	}
+	return ""
+}
 
-func (m *DelInfraApplyReq) GetID() string {
+func (m *GetInfraApplyStatsReq) GetApplyer() string {
 	if m != nil {
-		return m.ID
+		return m.Applyer
 	}
 	return ""
 }
 
-func (m *DelInfraApplyReq) GetUid() string {
+func (m *GetInfraApplyStatsReq) GetReviewer() string {
 	if m != nil {
-		return m.Uid
+		return m.Reviewer
 	}
 	return ""
 }
 
-type DelInfraApplyReply struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *GetInfraApplyStatsReq) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type InfraApplyStatsGroup struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	SubjectName          string   `protobuf:"bytes,3,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
+	Applyer              string   `protobuf:"bytes,4,opt,name=applyer,proto3" json:"applyer,omitempty"`
+	Reviewer             string   `protobuf:"bytes,5,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Total                int64    `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Active               int64    `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	Reviewed             int64    `protobuf:"varint,8,opt,name=reviewed,proto3" json:"reviewed,omitempty"`
+	LatencyP50Sec        float64  `protobuf:"fixed64,9,opt,name=latencyP50Sec,proto3" json:"latencyP50Sec,omitempty"`
+	LatencyP90Sec        float64  `protobuf:"fixed64,10,opt,name=latencyP90Sec,proto3" json:"latencyP90Sec,omitempty"`
+	LatencyP99Sec        float64  `protobuf:"fixed64,11,opt,name=latencyP99Sec,proto3" json:"latencyP99Sec,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DelInfraApplyReply) Reset()         { *m = DelInfraApplyReply{} }
-func (m *DelInfraApplyReply) String() string { return proto.CompactTextString(m) }
-func (*DelInfraApplyReply) ProtoMessage()    {}
-func (*DelInfraApplyReply) Descriptor() ([]byte, []int) {
+func (m *InfraApplyStatsGroup) Reset()         { *m = InfraApplyStatsGroup{} }
+func (m *InfraApplyStatsGroup) String() string { return proto.CompactTextString(m) }
+func (*InfraApplyStatsGroup) ProtoMessage()    {}
+func (*InfraApplyStatsGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{8}
+}
+
+func (m *InfraApplyStatsGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfraApplyStatsGroup.Unmarshal(m, b)
+}
+func (m *InfraApplyStatsGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfraApplyStatsGroup.Marshal(b, m, deterministic)
+}
+func (m *InfraApplyStatsGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfraApplyStatsGroup.Merge(m, src)
+}
+func (m *InfraApplyStatsGroup) XXX_Size() int {
+	return xxx_messageInfo_InfraApplyStatsGroup.Size(m)
+}
+func (m *InfraApplyStatsGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfraApplyStatsGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfraApplyStatsGroup proto.InternalMessageInfo
+
+func (m *InfraApplyStatsGroup) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *InfraApplyStatsGroup) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *InfraApplyStatsGroup) GetSubjectName() string {
+	if m != nil {
+		return m.SubjectName
+	}
+	return ""
+}
+
+func (m *InfraApplyStatsGroup) GetApplyer() string {
+	if m != nil {
+		return m.Applyer
+	}
+	return ""
+}
+
+func (m *InfraApplyStatsGroup) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
+	}
+	return ""
+}
+
+func (m *InfraApplyStatsGroup) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *InfraApplyStatsGroup) GetActive() int64 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
+}
+
+func (m *InfraApplyStatsGroup) GetReviewed() int64 {
+	if m != nil {
+		return m.Reviewed
+	}
+	return 0
+}
+
+func (m *InfraApplyStatsGroup) GetLatencyP50Sec() float64 {
+	if m != nil {
+		return m.LatencyP50Sec
+	}
+	return 0
+}
+
+func (m *InfraApplyStatsGroup) GetLatencyP90Sec() float64 {
+	if m != nil {
+		return m.LatencyP90Sec
+	}
+	return 0
+}
+
+func (m *InfraApplyStatsGroup) GetLatencyP99Sec() float64 {
+	if m != nil {
+		return m.LatencyP99Sec
+	}
+	return 0
+}
+
+type GetInfraApplyStatsReply struct {
+	Group                []*InfraApplyStatsGroup `protobuf:"bytes,1,rep,name=group,proto3" json:"group,omitempty"`
+	RefreshTM            string                  `protobuf:"bytes,2,opt,name=refreshTM,proto3" json:"refreshTM,omitempty"`
+	Truncated            bool                    `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetInfraApplyStatsReply) Reset()         { *m = GetInfraApplyStatsReply{} }
+func (m *GetInfraApplyStatsReply) String() string { return proto.CompactTextString(m) }
+func (*GetInfraApplyStatsReply) ProtoMessage()    {}
+func (*GetInfraApplyStatsReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1659b64737a352e6, []int{9}
 }
 
-func (m *DelInfraApplyReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DelInfraApplyReply.Unmarshal(m, b)
+func (m *GetInfraApplyStatsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfraApplyStatsReply.Unmarshal(m, b)
+}
+func (m *GetInfraApplyStatsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfraApplyStatsReply.Marshal(b, m, deterministic)
+}
+func (m *GetInfraApplyStatsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfraApplyStatsReply.Merge(m, src)
+}
+func (m *GetInfraApplyStatsReply) XXX_Size() int {
+	return xxx_messageInfo_GetInfraApplyStatsReply.Size(m)
+}
+func (m *GetInfraApplyStatsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfraApplyStatsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfraApplyStatsReply proto.InternalMessageInfo
+
+func (m *GetInfraApplyStatsReply) GetGroup() []*InfraApplyStatsGroup {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (m *GetInfraApplyStatsReply) GetRefreshTM() string {
+	if m != nil {
+		return m.RefreshTM
+	}
+	return ""
+}
+
+func (m *GetInfraApplyStatsReply) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+// Get
+type GetInfraApplyReq struct {
+	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetInfraApplyReq) Reset()         { *m = GetInfraApplyReq{} }
+func (m *GetInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*GetInfraApplyReq) ProtoMessage()    {}
+func (*GetInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{10}
+}
+
+func (m *GetInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfraApplyReq.Unmarshal(m, b)
+}
+func (m *GetInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *GetInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfraApplyReq.Merge(m, src)
+}
+func (m *GetInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_GetInfraApplyReq.Size(m)
+}
+func (m *GetInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfraApplyReq proto.InternalMessageInfo
+
+func (m *GetInfraApplyReq) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+// Add
+type AddInfraApplyReq struct {
+	DeviceCode           string   `protobuf:"bytes,1,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	SubjectName          string   `protobuf:"bytes,3,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
+	ExpireTM             string   `protobuf:"bytes,4,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddInfraApplyReq) Reset()         { *m = AddInfraApplyReq{} }
+func (m *AddInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*AddInfraApplyReq) ProtoMessage()    {}
+func (*AddInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{11}
+}
+
+func (m *AddInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInfraApplyReq.Unmarshal(m, b)
+}
+func (m *AddInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *AddInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInfraApplyReq.Merge(m, src)
+}
+func (m *AddInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_AddInfraApplyReq.Size(m)
+}
+func (m *AddInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddInfraApplyReq proto.InternalMessageInfo
+
+func (m *AddInfraApplyReq) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReq) GetSubjectName() string {
+	if m != nil {
+		return m.SubjectName
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReq) GetExpireTM() string {
+	if m != nil {
+		return m.ExpireTM
+	}
+	return ""
+}
+
+type AddInfraApplyReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ID                   int32    `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PolicyRule           string   `protobuf:"bytes,4,opt,name=policyRule,proto3" json:"policyRule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddInfraApplyReply) Reset()         { *m = AddInfraApplyReply{} }
+func (m *AddInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*AddInfraApplyReply) ProtoMessage()    {}
+func (*AddInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{12}
+}
+
+func (m *AddInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInfraApplyReply.Unmarshal(m, b)
+}
+func (m *AddInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *AddInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInfraApplyReply.Merge(m, src)
+}
+func (m *AddInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_AddInfraApplyReply.Size(m)
+}
+func (m *AddInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddInfraApplyReply proto.InternalMessageInfo
+
+func (m *AddInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReply) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AddInfraApplyReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReply) GetPolicyRule() string {
+	if m != nil {
+		return m.PolicyRule
+	}
+	return ""
+}
+
+// Update
+type UpdateInfraApplyReq struct {
+	ID                   int32                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status               string                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ExpireTM             string                `protobuf:"bytes,3,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+	Apply                *InfraApplyPatch      `protobuf:"bytes,4,opt,name=apply,proto3" json:"apply,omitempty"`
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,5,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateInfraApplyReq) Reset()         { *m = UpdateInfraApplyReq{} }
+func (m *UpdateInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfraApplyReq) ProtoMessage()    {}
+func (*UpdateInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{13}
+}
+
+func (m *UpdateInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateInfraApplyReq.Unmarshal(m, b)
+}
+func (m *UpdateInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *UpdateInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfraApplyReq.Merge(m, src)
+}
+func (m *UpdateInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_UpdateInfraApplyReq.Size(m)
+}
+func (m *UpdateInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfraApplyReq proto.InternalMessageInfo
+
+func (m *UpdateInfraApplyReq) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *UpdateInfraApplyReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *UpdateInfraApplyReq) GetExpireTM() string {
+	if m != nil {
+		return m.ExpireTM
+	}
+	return ""
+}
+
+func (m *UpdateInfraApplyReq) GetApply() *InfraApplyPatch {
+	if m != nil {
+		return m.Apply
+	}
+	return nil
+}
+
+func (m *UpdateInfraApplyReq) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// the fields of an apply a review can change, status is always needed
+type InfraApplyPatch struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ExpireTM             string   `protobuf:"bytes,2,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+	Comment              string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InfraApplyPatch) Reset()         { *m = InfraApplyPatch{} }
+func (m *InfraApplyPatch) String() string { return proto.CompactTextString(m) }
+func (*InfraApplyPatch) ProtoMessage()    {}
+func (*InfraApplyPatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{14}
+}
+
+func (m *InfraApplyPatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfraApplyPatch.Unmarshal(m, b)
+}
+func (m *InfraApplyPatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfraApplyPatch.Marshal(b, m, deterministic)
+}
+func (m *InfraApplyPatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfraApplyPatch.Merge(m, src)
+}
+func (m *InfraApplyPatch) XXX_Size() int {
+	return xxx_messageInfo_InfraApplyPatch.Size(m)
+}
+func (m *InfraApplyPatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfraApplyPatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfraApplyPatch proto.InternalMessageInfo
+
+func (m *InfraApplyPatch) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *InfraApplyPatch) GetExpireTM() string {
+	if m != nil {
+		return m.ExpireTM
+	}
+	return ""
+}
+
+func (m *InfraApplyPatch) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+// Extend
+type ExtendInfraApplyReq struct {
+	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	ExpireTM             string   `protobuf:"bytes,3,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+	Comment              string   `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtendInfraApplyReq) Reset()         { *m = ExtendInfraApplyReq{} }
+func (m *ExtendInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*ExtendInfraApplyReq) ProtoMessage()    {}
+func (*ExtendInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{15}
+}
+
+func (m *ExtendInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendInfraApplyReq.Unmarshal(m, b)
+}
+func (m *ExtendInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *ExtendInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendInfraApplyReq.Merge(m, src)
+}
+func (m *ExtendInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_ExtendInfraApplyReq.Size(m)
+}
+func (m *ExtendInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendInfraApplyReq proto.InternalMessageInfo
+
+func (m *ExtendInfraApplyReq) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ExtendInfraApplyReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ExtendInfraApplyReq) GetExpireTM() string {
+	if m != nil {
+		return m.ExpireTM
+	}
+	return ""
+}
+
+func (m *ExtendInfraApplyReq) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type ExtendInfraApplyReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ID                   int32    `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PolicyRule           string   `protobuf:"bytes,4,opt,name=policyRule,proto3" json:"policyRule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtendInfraApplyReply) Reset()         { *m = ExtendInfraApplyReply{} }
+func (m *ExtendInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*ExtendInfraApplyReply) ProtoMessage()    {}
+func (*ExtendInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{16}
+}
+
+func (m *ExtendInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendInfraApplyReply.Unmarshal(m, b)
+}
+func (m *ExtendInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *ExtendInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendInfraApplyReply.Merge(m, src)
+}
+func (m *ExtendInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_ExtendInfraApplyReply.Size(m)
+}
+func (m *ExtendInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendInfraApplyReply proto.InternalMessageInfo
+
+func (m *ExtendInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *ExtendInfraApplyReply) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ExtendInfraApplyReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ExtendInfraApplyReply) GetPolicyRule() string {
+	if m != nil {
+		return m.PolicyRule
+	}
+	return ""
+}
+
+type UpdateInfraApplyReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateInfraApplyReply) Reset()         { *m = UpdateInfraApplyReply{} }
+func (m *UpdateInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfraApplyReply) ProtoMessage()    {}
+func (*UpdateInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{17}
+}
+
+func (m *UpdateInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateInfraApplyReply.Unmarshal(m, b)
+}
+func (m *UpdateInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *UpdateInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfraApplyReply.Merge(m, src)
+}
+func (m *UpdateInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_UpdateInfraApplyReply.Size(m)
+}
+func (m *UpdateInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfraApplyReply proto.InternalMessageInfo
+
+func (m *UpdateInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *UpdateInfraApplyReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// Batch update
+type BatchUpdateInfraApplyReq struct {
+	IDs                  []int32  `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	Search               string   `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ExpireTM             string   `protobuf:"bytes,4,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+	Comment              string   `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	BestEffort           bool     `protobuf:"varint,6,opt,name=bestEffort,proto3" json:"bestEffort,omitempty"`
+	DryRun               bool     `protobuf:"varint,7,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Applyer              string   `protobuf:"bytes,8,opt,name=applyer,proto3" json:"applyer,omitempty"`
+	DeviceCode           string   `protobuf:"bytes,9,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
+	ExtendsID            int32    `protobuf:"varint,10,opt,name=extendsID,proto3" json:"extendsID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchUpdateInfraApplyReq) Reset()         { *m = BatchUpdateInfraApplyReq{} }
+func (m *BatchUpdateInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateInfraApplyReq) ProtoMessage()    {}
+func (*BatchUpdateInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{18}
+}
+
+func (m *BatchUpdateInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateInfraApplyReq.Unmarshal(m, b)
+}
+func (m *BatchUpdateInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateInfraApplyReq.Merge(m, src)
+}
+func (m *BatchUpdateInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateInfraApplyReq.Size(m)
+}
+func (m *BatchUpdateInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateInfraApplyReq proto.InternalMessageInfo
+
+func (m *BatchUpdateInfraApplyReq) GetIDs() []int32 {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
+}
+
+func (m *BatchUpdateInfraApplyReq) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *BatchUpdateInfraApplyReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *BatchUpdateInfraApplyReq) GetExpireTM() string {
+	if m != nil {
+		return m.ExpireTM
+	}
+	return ""
+}
+
+func (m *BatchUpdateInfraApplyReq) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *BatchUpdateInfraApplyReq) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
+func (m *BatchUpdateInfraApplyReq) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *BatchUpdateInfraApplyReq) GetApplyer() string {
+	if m != nil {
+		return m.Applyer
+	}
+	return ""
+}
+
+func (m *BatchUpdateInfraApplyReq) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *BatchUpdateInfraApplyReq) GetExtendsID() int32 {
+	if m != nil {
+		return m.ExtendsID
+	}
+	return 0
+}
+
+type BatchUpdateItem struct {
+	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Result               string   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchUpdateItem) Reset()         { *m = BatchUpdateItem{} }
+func (m *BatchUpdateItem) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateItem) ProtoMessage()    {}
+func (*BatchUpdateItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{19}
+}
+
+func (m *BatchUpdateItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateItem.Unmarshal(m, b)
+}
+func (m *BatchUpdateItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateItem.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateItem.Merge(m, src)
+}
+func (m *BatchUpdateItem) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateItem.Size(m)
+}
+func (m *BatchUpdateItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateItem proto.InternalMessageInfo
+
+func (m *BatchUpdateItem) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *BatchUpdateItem) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *BatchUpdateItem) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type BatchUpdateInfraApplyReply struct {
+	Result               string             `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Items                []*BatchUpdateItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Succeeded            int32              `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed               int32              `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BatchUpdateInfraApplyReply) Reset()         { *m = BatchUpdateInfraApplyReply{} }
+func (m *BatchUpdateInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*BatchUpdateInfraApplyReply) ProtoMessage()    {}
+func (*BatchUpdateInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{20}
+}
+
+func (m *BatchUpdateInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchUpdateInfraApplyReply.Unmarshal(m, b)
+}
+func (m *BatchUpdateInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchUpdateInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *BatchUpdateInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchUpdateInfraApplyReply.Merge(m, src)
+}
+func (m *BatchUpdateInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_BatchUpdateInfraApplyReply.Size(m)
+}
+func (m *BatchUpdateInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchUpdateInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchUpdateInfraApplyReply proto.InternalMessageInfo
+
+func (m *BatchUpdateInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *BatchUpdateInfraApplyReply) GetItems() []*BatchUpdateItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *BatchUpdateInfraApplyReply) GetSucceeded() int32 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *BatchUpdateInfraApplyReply) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+// Review
+type ListInfraApplyReviewReq struct {
+	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInfraApplyReviewReq) Reset()         { *m = ListInfraApplyReviewReq{} }
+func (m *ListInfraApplyReviewReq) String() string { return proto.CompactTextString(m) }
+func (*ListInfraApplyReviewReq) ProtoMessage()    {}
+func (*ListInfraApplyReviewReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{21}
+}
+
+func (m *ListInfraApplyReviewReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInfraApplyReviewReq.Unmarshal(m, b)
+}
+func (m *ListInfraApplyReviewReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInfraApplyReviewReq.Marshal(b, m, deterministic)
+}
+func (m *ListInfraApplyReviewReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInfraApplyReviewReq.Merge(m, src)
+}
+func (m *ListInfraApplyReviewReq) XXX_Size() int {
+	return xxx_messageInfo_ListInfraApplyReviewReq.Size(m)
+}
+func (m *ListInfraApplyReviewReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInfraApplyReviewReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInfraApplyReviewReq proto.InternalMessageInfo
+
+func (m *ListInfraApplyReviewReq) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+type InfraApplyReviewDetail struct {
+	Stage                int32    `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Reviewer             string   `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Decision             string   `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"`
+	Comment              string   `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	ReviewTM             string   `protobuf:"bytes,5,opt,name=reviewTM,proto3" json:"reviewTM,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InfraApplyReviewDetail) Reset()         { *m = InfraApplyReviewDetail{} }
+func (m *InfraApplyReviewDetail) String() string { return proto.CompactTextString(m) }
+func (*InfraApplyReviewDetail) ProtoMessage()    {}
+func (*InfraApplyReviewDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{22}
+}
+
+func (m *InfraApplyReviewDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfraApplyReviewDetail.Unmarshal(m, b)
+}
+func (m *InfraApplyReviewDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfraApplyReviewDetail.Marshal(b, m, deterministic)
+}
+func (m *InfraApplyReviewDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfraApplyReviewDetail.Merge(m, src)
+}
+func (m *InfraApplyReviewDetail) XXX_Size() int {
+	return xxx_messageInfo_InfraApplyReviewDetail.Size(m)
+}
+func (m *InfraApplyReviewDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfraApplyReviewDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfraApplyReviewDetail proto.InternalMessageInfo
+
+func (m *InfraApplyReviewDetail) GetStage() int32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
+}
+
+func (m *InfraApplyReviewDetail) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
+	}
+	return ""
+}
+
+func (m *InfraApplyReviewDetail) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
+}
+
+func (m *InfraApplyReviewDetail) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *InfraApplyReviewDetail) GetReviewTM() string {
+	if m != nil {
+		return m.ReviewTM
+	}
+	return ""
+}
+
+type ListInfraApplyReviewReply struct {
+	Record               []*InfraApplyReviewDetail `protobuf:"bytes,1,rep,name=record,proto3" json:"record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListInfraApplyReviewReply) Reset()         { *m = ListInfraApplyReviewReply{} }
+func (m *ListInfraApplyReviewReply) String() string { return proto.CompactTextString(m) }
+func (*ListInfraApplyReviewReply) ProtoMessage()    {}
+func (*ListInfraApplyReviewReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{23}
+}
+
+func (m *ListInfraApplyReviewReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInfraApplyReviewReply.Unmarshal(m, b)
+}
+func (m *ListInfraApplyReviewReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInfraApplyReviewReply.Marshal(b, m, deterministic)
+}
+func (m *ListInfraApplyReviewReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInfraApplyReviewReply.Merge(m, src)
+}
+func (m *ListInfraApplyReviewReply) XXX_Size() int {
+	return xxx_messageInfo_ListInfraApplyReviewReply.Size(m)
+}
+func (m *ListInfraApplyReviewReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInfraApplyReviewReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInfraApplyReviewReply proto.InternalMessageInfo
+
+func (m *ListInfraApplyReviewReply) GetRecord() []*InfraApplyReviewDetail {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+// Workflow
+type WorkflowStage struct {
+	Seq                  int32    `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reviewers            []string `protobuf:"bytes,3,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Quorum               int32    `protobuf:"varint,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowStage) Reset()         { *m = WorkflowStage{} }
+func (m *WorkflowStage) String() string { return proto.CompactTextString(m) }
+func (*WorkflowStage) ProtoMessage()    {}
+func (*WorkflowStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{24}
+}
+
+func (m *WorkflowStage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowStage.Unmarshal(m, b)
+}
+func (m *WorkflowStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowStage.Marshal(b, m, deterministic)
+}
+func (m *WorkflowStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowStage.Merge(m, src)
+}
+func (m *WorkflowStage) XXX_Size() int {
+	return xxx_messageInfo_WorkflowStage.Size(m)
+}
+func (m *WorkflowStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowStage proto.InternalMessageInfo
+
+func (m *WorkflowStage) GetSeq() int32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *WorkflowStage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowStage) GetReviewers() []string {
+	if m != nil {
+		return m.Reviewers
+	}
+	return nil
+}
+
+func (m *WorkflowStage) GetQuorum() int32 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+type WorkflowDetail struct {
+	ID                   int32            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string           `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Stages               []*WorkflowStage `protobuf:"bytes,4,rep,name=stages,proto3" json:"stages,omitempty"`
+	Subjects             []string         `protobuf:"bytes,5,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WorkflowDetail) Reset()         { *m = WorkflowDetail{} }
+func (m *WorkflowDetail) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDetail) ProtoMessage()    {}
+func (*WorkflowDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{25}
+}
+
+func (m *WorkflowDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowDetail.Unmarshal(m, b)
+}
+func (m *WorkflowDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowDetail.Marshal(b, m, deterministic)
+}
+func (m *WorkflowDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDetail.Merge(m, src)
+}
+func (m *WorkflowDetail) XXX_Size() int {
+	return xxx_messageInfo_WorkflowDetail.Size(m)
+}
+func (m *WorkflowDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDetail proto.InternalMessageInfo
+
+func (m *WorkflowDetail) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *WorkflowDetail) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowDetail) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *WorkflowDetail) GetStages() []*WorkflowStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *WorkflowDetail) GetSubjects() []string {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+type SaveWorkflowReq struct {
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Stages               []*WorkflowStage `protobuf:"bytes,3,rep,name=stages,proto3" json:"stages,omitempty"`
+	Subjects             []string         `protobuf:"bytes,4,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SaveWorkflowReq) Reset()         { *m = SaveWorkflowReq{} }
+func (m *SaveWorkflowReq) String() string { return proto.CompactTextString(m) }
+func (*SaveWorkflowReq) ProtoMessage()    {}
+func (*SaveWorkflowReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{26}
+}
+
+func (m *SaveWorkflowReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveWorkflowReq.Unmarshal(m, b)
+}
+func (m *SaveWorkflowReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveWorkflowReq.Marshal(b, m, deterministic)
+}
+func (m *SaveWorkflowReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveWorkflowReq.Merge(m, src)
+}
+func (m *SaveWorkflowReq) XXX_Size() int {
+	return xxx_messageInfo_SaveWorkflowReq.Size(m)
+}
+func (m *SaveWorkflowReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveWorkflowReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveWorkflowReq proto.InternalMessageInfo
+
+func (m *SaveWorkflowReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SaveWorkflowReq) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SaveWorkflowReq) GetStages() []*WorkflowStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *SaveWorkflowReq) GetSubjects() []string {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+type SaveWorkflowReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ID                   int32    `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SaveWorkflowReply) Reset()         { *m = SaveWorkflowReply{} }
+func (m *SaveWorkflowReply) String() string { return proto.CompactTextString(m) }
+func (*SaveWorkflowReply) ProtoMessage()    {}
+func (*SaveWorkflowReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{27}
+}
+
+func (m *SaveWorkflowReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveWorkflowReply.Unmarshal(m, b)
+}
+func (m *SaveWorkflowReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveWorkflowReply.Marshal(b, m, deterministic)
+}
+func (m *SaveWorkflowReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveWorkflowReply.Merge(m, src)
+}
+func (m *SaveWorkflowReply) XXX_Size() int {
+	return xxx_messageInfo_SaveWorkflowReply.Size(m)
+}
+func (m *SaveWorkflowReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveWorkflowReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveWorkflowReply proto.InternalMessageInfo
+
+func (m *SaveWorkflowReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *SaveWorkflowReply) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+type ListWorkflowReq struct {
+	PageIdx              int32    `protobuf:"varint,1,opt,name=pageIdx,proto3" json:"pageIdx,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWorkflowReq) Reset()         { *m = ListWorkflowReq{} }
+func (m *ListWorkflowReq) String() string { return proto.CompactTextString(m) }
+func (*ListWorkflowReq) ProtoMessage()    {}
+func (*ListWorkflowReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{28}
+}
+
+func (m *ListWorkflowReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWorkflowReq.Unmarshal(m, b)
+}
+func (m *ListWorkflowReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWorkflowReq.Marshal(b, m, deterministic)
+}
+func (m *ListWorkflowReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkflowReq.Merge(m, src)
+}
+func (m *ListWorkflowReq) XXX_Size() int {
+	return xxx_messageInfo_ListWorkflowReq.Size(m)
+}
+func (m *ListWorkflowReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkflowReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkflowReq proto.InternalMessageInfo
+
+func (m *ListWorkflowReq) GetPageIdx() int32 {
+	if m != nil {
+		return m.PageIdx
+	}
+	return 0
+}
+
+func (m *ListWorkflowReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type ListWorkflowReply struct {
+	Page                 *ModelPage        `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Record               []*WorkflowDetail `protobuf:"bytes,2,rep,name=record,proto3" json:"record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListWorkflowReply) Reset()         { *m = ListWorkflowReply{} }
+func (m *ListWorkflowReply) String() string { return proto.CompactTextString(m) }
+func (*ListWorkflowReply) ProtoMessage()    {}
+func (*ListWorkflowReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{29}
+}
+
+func (m *ListWorkflowReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWorkflowReply.Unmarshal(m, b)
+}
+func (m *ListWorkflowReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWorkflowReply.Marshal(b, m, deterministic)
+}
+func (m *ListWorkflowReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkflowReply.Merge(m, src)
+}
+func (m *ListWorkflowReply) XXX_Size() int {
+	return xxx_messageInfo_ListWorkflowReply.Size(m)
+}
+func (m *ListWorkflowReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkflowReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkflowReply proto.InternalMessageInfo
+
+func (m *ListWorkflowReply) GetPage() *ModelPage {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *ListWorkflowReply) GetRecord() []*WorkflowDetail {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+// Policy
+type SimulatePolicyReq struct {
+	DeviceCode           string   `protobuf:"bytes,1,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	SubjectName          string   `protobuf:"bytes,3,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
+	ExpireTM             string   `protobuf:"bytes,4,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+	EvalTM               string   `protobuf:"bytes,5,opt,name=evalTM,proto3" json:"evalTM,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulatePolicyReq) Reset()         { *m = SimulatePolicyReq{} }
+func (m *SimulatePolicyReq) String() string { return proto.CompactTextString(m) }
+func (*SimulatePolicyReq) ProtoMessage()    {}
+func (*SimulatePolicyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{30}
+}
+
+func (m *SimulatePolicyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulatePolicyReq.Unmarshal(m, b)
+}
+func (m *SimulatePolicyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulatePolicyReq.Marshal(b, m, deterministic)
+}
+func (m *SimulatePolicyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatePolicyReq.Merge(m, src)
+}
+func (m *SimulatePolicyReq) XXX_Size() int {
+	return xxx_messageInfo_SimulatePolicyReq.Size(m)
+}
+func (m *SimulatePolicyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatePolicyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatePolicyReq proto.InternalMessageInfo
+
+func (m *SimulatePolicyReq) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *SimulatePolicyReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SimulatePolicyReq) GetSubjectName() string {
+	if m != nil {
+		return m.SubjectName
+	}
+	return ""
+}
+
+func (m *SimulatePolicyReq) GetExpireTM() string {
+	if m != nil {
+		return m.ExpireTM
+	}
+	return ""
+}
+
+func (m *SimulatePolicyReq) GetEvalTM() string {
+	if m != nil {
+		return m.EvalTM
+	}
+	return ""
+}
+
+type PolicyRuleResult struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Matched              bool     `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyRuleResult) Reset()         { *m = PolicyRuleResult{} }
+func (m *PolicyRuleResult) String() string { return proto.CompactTextString(m) }
+func (*PolicyRuleResult) ProtoMessage()    {}
+func (*PolicyRuleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{31}
+}
+
+func (m *PolicyRuleResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyRuleResult.Unmarshal(m, b)
+}
+func (m *PolicyRuleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyRuleResult.Marshal(b, m, deterministic)
+}
+func (m *PolicyRuleResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyRuleResult.Merge(m, src)
+}
+func (m *PolicyRuleResult) XXX_Size() int {
+	return xxx_messageInfo_PolicyRuleResult.Size(m)
+}
+func (m *PolicyRuleResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyRuleResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyRuleResult proto.InternalMessageInfo
+
+func (m *PolicyRuleResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PolicyRuleResult) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
+func (m *PolicyRuleResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SimulatePolicyReply struct {
+	Rule                 string              `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Action               string              `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Workflow             string              `protobuf:"bytes,3,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Results              []*PolicyRuleResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SimulatePolicyReply) Reset()         { *m = SimulatePolicyReply{} }
+func (m *SimulatePolicyReply) String() string { return proto.CompactTextString(m) }
+func (*SimulatePolicyReply) ProtoMessage()    {}
+func (*SimulatePolicyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{32}
+}
+
+func (m *SimulatePolicyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulatePolicyReply.Unmarshal(m, b)
+}
+func (m *SimulatePolicyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulatePolicyReply.Marshal(b, m, deterministic)
+}
+func (m *SimulatePolicyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatePolicyReply.Merge(m, src)
+}
+func (m *SimulatePolicyReply) XXX_Size() int {
+	return xxx_messageInfo_SimulatePolicyReply.Size(m)
+}
+func (m *SimulatePolicyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatePolicyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatePolicyReply proto.InternalMessageInfo
+
+func (m *SimulatePolicyReply) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *SimulatePolicyReply) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *SimulatePolicyReply) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *SimulatePolicyReply) GetResults() []*PolicyRuleResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// Import
+type ImportInfraApplyRow struct {
+	Line                 int32    `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	DeviceCode           string   `protobuf:"bytes,2,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
+	Uid                  string   `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	SubjectName          string   `protobuf:"bytes,4,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ExpireTM             string   `protobuf:"bytes,6,opt,name=expireTM,proto3" json:"expireTM,omitempty"`
+	ReviewId             string   `protobuf:"bytes,7,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	ReviewTM             string   `protobuf:"bytes,8,opt,name=reviewTM,proto3" json:"reviewTM,omitempty"`
+	Comment              string   `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportInfraApplyRow) Reset()         { *m = ImportInfraApplyRow{} }
+func (m *ImportInfraApplyRow) String() string { return proto.CompactTextString(m) }
+func (*ImportInfraApplyRow) ProtoMessage()    {}
+func (*ImportInfraApplyRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{33}
+}
+
+func (m *ImportInfraApplyRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportInfraApplyRow.Unmarshal(m, b)
+}
+func (m *ImportInfraApplyRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportInfraApplyRow.Marshal(b, m, deterministic)
+}
+func (m *ImportInfraApplyRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportInfraApplyRow.Merge(m, src)
+}
+func (m *ImportInfraApplyRow) XXX_Size() int {
+	return xxx_messageInfo_ImportInfraApplyRow.Size(m)
+}
+func (m *ImportInfraApplyRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportInfraApplyRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportInfraApplyRow proto.InternalMessageInfo
+
+func (m *ImportInfraApplyRow) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *ImportInfraApplyRow) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyRow) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyRow) GetSubjectName() string {
+	if m != nil {
+		return m.SubjectName
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyRow) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyRow) GetExpireTM() string {
+	if m != nil {
+		return m.ExpireTM
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyRow) GetReviewId() string {
+	if m != nil {
+		return m.ReviewId
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyRow) GetReviewTM() string {
+	if m != nil {
+		return m.ReviewTM
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyRow) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type ImportInfraApplyReq struct {
+	DryRun               bool                 `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Upsert               bool                 `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	Row                  *ImportInfraApplyRow `protobuf:"bytes,3,opt,name=row,proto3" json:"row,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportInfraApplyReq) Reset()         { *m = ImportInfraApplyReq{} }
+func (m *ImportInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*ImportInfraApplyReq) ProtoMessage()    {}
+func (*ImportInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{34}
+}
+
+func (m *ImportInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportInfraApplyReq.Unmarshal(m, b)
+}
+func (m *ImportInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *ImportInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportInfraApplyReq.Merge(m, src)
+}
+func (m *ImportInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_ImportInfraApplyReq.Size(m)
+}
+func (m *ImportInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportInfraApplyReq proto.InternalMessageInfo
+
+func (m *ImportInfraApplyReq) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportInfraApplyReq) GetUpsert() bool {
+	if m != nil {
+		return m.Upsert
+	}
+	return false
+}
+
+func (m *ImportInfraApplyReq) GetRow() *ImportInfraApplyRow {
+	if m != nil {
+		return m.Row
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	Line                 int32    `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRowError) Reset()         { *m = ImportRowError{} }
+func (m *ImportRowError) String() string { return proto.CompactTextString(m) }
+func (*ImportRowError) ProtoMessage()    {}
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{35}
+}
+
+func (m *ImportRowError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRowError.Unmarshal(m, b)
+}
+func (m *ImportRowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRowError.Marshal(b, m, deterministic)
+}
+func (m *ImportRowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRowError.Merge(m, src)
+}
+func (m *ImportRowError) XXX_Size() int {
+	return xxx_messageInfo_ImportRowError.Size(m)
+}
+func (m *ImportRowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRowError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRowError proto.InternalMessageInfo
+
+func (m *ImportRowError) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *ImportRowError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImportInfraApplyReply struct {
+	Result               string            `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	BatchID              string            `protobuf:"bytes,2,opt,name=batchID,proto3" json:"batchID,omitempty"`
+	Total                int32             `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Created              int32             `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Updated              int32             `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed               int32             `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors               []*ImportRowError `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportInfraApplyReply) Reset()         { *m = ImportInfraApplyReply{} }
+func (m *ImportInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*ImportInfraApplyReply) ProtoMessage()    {}
+func (*ImportInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{36}
+}
+
+func (m *ImportInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportInfraApplyReply.Unmarshal(m, b)
+}
+func (m *ImportInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *ImportInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportInfraApplyReply.Merge(m, src)
+}
+func (m *ImportInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_ImportInfraApplyReply.Size(m)
+}
+func (m *ImportInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportInfraApplyReply proto.InternalMessageInfo
+
+func (m *ImportInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyReply) GetBatchID() string {
+	if m != nil {
+		return m.BatchID
+	}
+	return ""
+}
+
+func (m *ImportInfraApplyReply) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ImportInfraApplyReply) GetCreated() int32 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *ImportInfraApplyReply) GetUpdated() int32 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+func (m *ImportInfraApplyReply) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ImportInfraApplyReply) GetErrors() []*ImportRowError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+// Delete
+type DelInfraApplyReq struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelInfraApplyReq) Reset()         { *m = DelInfraApplyReq{} }
+func (m *DelInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*DelInfraApplyReq) ProtoMessage()    {}
+func (*DelInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{37}
+}
+
+func (m *DelInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelInfraApplyReq.Unmarshal(m, b)
+}
+func (m *DelInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *DelInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelInfraApplyReq.Merge(m, src)
+}
+func (m *DelInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_DelInfraApplyReq.Size(m)
+}
+func (m *DelInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelInfraApplyReq proto.InternalMessageInfo
+
+func (m *DelInfraApplyReq) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *DelInfraApplyReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type DelInfraApplyReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelInfraApplyReply) Reset()         { *m = DelInfraApplyReply{} }
+func (m *DelInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*DelInfraApplyReply) ProtoMessage()    {}
+func (*DelInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{38}
+}
+
+func (m *DelInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelInfraApplyReply.Unmarshal(m, b)
+}
+func (m *DelInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *DelInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelInfraApplyReply.Merge(m, src)
+}
+func (m *DelInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_DelInfraApplyReply.Size(m)
+}
+func (m *DelInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelInfraApplyReply proto.InternalMessageInfo
+
+func (m *DelInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+// Device
+type DeviceDetail struct {
+	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Owner                string   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Environment          string   `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`
+	Tags                 []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	State                string   `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceDetail) Reset()         { *m = DeviceDetail{} }
+func (m *DeviceDetail) String() string { return proto.CompactTextString(m) }
+func (*DeviceDetail) ProtoMessage()    {}
+func (*DeviceDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{39}
+}
+
+func (m *DeviceDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceDetail.Unmarshal(m, b)
+}
+func (m *DeviceDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceDetail.Marshal(b, m, deterministic)
+}
+func (m *DeviceDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceDetail.Merge(m, src)
+}
+func (m *DeviceDetail) XXX_Size() int {
+	return xxx_messageInfo_DeviceDetail.Size(m)
+}
+func (m *DeviceDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceDetail proto.InternalMessageInfo
+
+func (m *DeviceDetail) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DeviceDetail) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *DeviceDetail) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceDetail) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *DeviceDetail) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *DeviceDetail) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *DeviceDetail) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+type AddDeviceReq struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Environment          string   `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	Tags                 []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	State                string   `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddDeviceReq) Reset()         { *m = AddDeviceReq{} }
+func (m *AddDeviceReq) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceReq) ProtoMessage()    {}
+func (*AddDeviceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{40}
+}
+
+func (m *AddDeviceReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDeviceReq.Unmarshal(m, b)
+}
+func (m *AddDeviceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDeviceReq.Marshal(b, m, deterministic)
+}
+func (m *AddDeviceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDeviceReq.Merge(m, src)
+}
+func (m *AddDeviceReq) XXX_Size() int {
+	return xxx_messageInfo_AddDeviceReq.Size(m)
+}
+func (m *AddDeviceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDeviceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDeviceReq proto.InternalMessageInfo
+
+func (m *AddDeviceReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *AddDeviceReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddDeviceReq) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AddDeviceReq) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *AddDeviceReq) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *AddDeviceReq) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+type AddDeviceReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ID                   int32    `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddDeviceReply) Reset()         { *m = AddDeviceReply{} }
+func (m *AddDeviceReply) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceReply) ProtoMessage()    {}
+func (*AddDeviceReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{41}
+}
+
+func (m *AddDeviceReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDeviceReply.Unmarshal(m, b)
+}
+func (m *AddDeviceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDeviceReply.Marshal(b, m, deterministic)
+}
+func (m *AddDeviceReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDeviceReply.Merge(m, src)
+}
+func (m *AddDeviceReply) XXX_Size() int {
+	return xxx_messageInfo_AddDeviceReply.Size(m)
+}
+func (m *AddDeviceReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDeviceReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDeviceReply proto.InternalMessageInfo
+
+func (m *AddDeviceReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *AddDeviceReply) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+// empty fields are left unchanged
+type UpdateDeviceReq struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Environment          string   `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	Tags                 []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	ClearTags            bool     `protobuf:"varint,6,opt,name=clearTags,proto3" json:"clearTags,omitempty"`
+	State                string   `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateDeviceReq) Reset()         { *m = UpdateDeviceReq{} }
+func (m *UpdateDeviceReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceReq) ProtoMessage()    {}
+func (*UpdateDeviceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{42}
+}
+
+func (m *UpdateDeviceReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceReq.Unmarshal(m, b)
+}
+func (m *UpdateDeviceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceReq.Marshal(b, m, deterministic)
+}
+func (m *UpdateDeviceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceReq.Merge(m, src)
+}
+func (m *UpdateDeviceReq) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceReq.Size(m)
+}
+func (m *UpdateDeviceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceReq proto.InternalMessageInfo
+
+func (m *UpdateDeviceReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *UpdateDeviceReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateDeviceReq) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *UpdateDeviceReq) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *UpdateDeviceReq) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *UpdateDeviceReq) GetClearTags() bool {
+	if m != nil {
+		return m.ClearTags
+	}
+	return false
+}
+
+func (m *UpdateDeviceReq) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+type UpdateDeviceReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateDeviceReply) Reset()         { *m = UpdateDeviceReply{} }
+func (m *UpdateDeviceReply) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceReply) ProtoMessage()    {}
+func (*UpdateDeviceReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{43}
+}
+
+func (m *UpdateDeviceReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceReply.Unmarshal(m, b)
+}
+func (m *UpdateDeviceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceReply.Marshal(b, m, deterministic)
+}
+func (m *UpdateDeviceReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceReply.Merge(m, src)
+}
+func (m *UpdateDeviceReply) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceReply.Size(m)
+}
+func (m *UpdateDeviceReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceReply proto.InternalMessageInfo
+
+func (m *UpdateDeviceReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+type GetDeviceReq struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceReq) Reset()         { *m = GetDeviceReq{} }
+func (m *GetDeviceReq) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceReq) ProtoMessage()    {}
+func (*GetDeviceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{44}
+}
+
+func (m *GetDeviceReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceReq.Unmarshal(m, b)
+}
+func (m *GetDeviceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceReq.Marshal(b, m, deterministic)
+}
+func (m *GetDeviceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceReq.Merge(m, src)
+}
+func (m *GetDeviceReq) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceReq.Size(m)
+}
+func (m *GetDeviceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceReq proto.InternalMessageInfo
+
+func (m *GetDeviceReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type ListDeviceReq struct {
+	PageIdx              int32    `protobuf:"varint,1,opt,name=pageIdx,proto3" json:"pageIdx,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Search               string   `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Owner                string   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Environment          string   `protobuf:"bytes,5,opt,name=environment,proto3" json:"environment,omitempty"`
+	State                string   `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	Tag                  string   `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceReq) Reset()         { *m = ListDeviceReq{} }
+func (m *ListDeviceReq) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceReq) ProtoMessage()    {}
+func (*ListDeviceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{45}
+}
+
+func (m *ListDeviceReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceReq.Unmarshal(m, b)
+}
+func (m *ListDeviceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceReq.Marshal(b, m, deterministic)
+}
+func (m *ListDeviceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceReq.Merge(m, src)
+}
+func (m *ListDeviceReq) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceReq.Size(m)
+}
+func (m *ListDeviceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceReq proto.InternalMessageInfo
+
+func (m *ListDeviceReq) GetPageIdx() int32 {
+	if m != nil {
+		return m.PageIdx
+	}
+	return 0
+}
+
+func (m *ListDeviceReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListDeviceReq) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ListDeviceReq) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ListDeviceReq) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *ListDeviceReq) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ListDeviceReq) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type ListDeviceReply struct {
+	Page                 *ModelPage      `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Record               []*DeviceDetail `protobuf:"bytes,2,rep,name=record,proto3" json:"record,omitempty"`
+	Exhausted            bool            `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListDeviceReply) Reset()         { *m = ListDeviceReply{} }
+func (m *ListDeviceReply) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceReply) ProtoMessage()    {}
+func (*ListDeviceReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{46}
+}
+
+func (m *ListDeviceReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceReply.Unmarshal(m, b)
+}
+func (m *ListDeviceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceReply.Marshal(b, m, deterministic)
+}
+func (m *ListDeviceReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceReply.Merge(m, src)
+}
+func (m *ListDeviceReply) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceReply.Size(m)
+}
+func (m *ListDeviceReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceReply proto.InternalMessageInfo
+
+func (m *ListDeviceReply) GetPage() *ModelPage {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *ListDeviceReply) GetRecord() []*DeviceDetail {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *ListDeviceReply) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
+	}
+	return false
+}
+
+type DelDeviceReq struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelDeviceReq) Reset()         { *m = DelDeviceReq{} }
+func (m *DelDeviceReq) String() string { return proto.CompactTextString(m) }
+func (*DelDeviceReq) ProtoMessage()    {}
+func (*DelDeviceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{47}
+}
+
+func (m *DelDeviceReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelDeviceReq.Unmarshal(m, b)
+}
+func (m *DelDeviceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelDeviceReq.Marshal(b, m, deterministic)
+}
+func (m *DelDeviceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelDeviceReq.Merge(m, src)
+}
+func (m *DelDeviceReq) XXX_Size() int {
+	return xxx_messageInfo_DelDeviceReq.Size(m)
+}
+func (m *DelDeviceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelDeviceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelDeviceReq proto.InternalMessageInfo
+
+func (m *DelDeviceReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type DelDeviceReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelDeviceReply) Reset()         { *m = DelDeviceReply{} }
+func (m *DelDeviceReply) String() string { return proto.CompactTextString(m) }
+func (*DelDeviceReply) ProtoMessage()    {}
+func (*DelDeviceReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{48}
+}
+
+func (m *DelDeviceReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelDeviceReply.Unmarshal(m, b)
+}
+func (m *DelDeviceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelDeviceReply.Marshal(b, m, deterministic)
+}
+func (m *DelDeviceReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelDeviceReply.Merge(m, src)
+}
+func (m *DelDeviceReply) XXX_Size() int {
+	return xxx_messageInfo_DelDeviceReply.Size(m)
+}
+func (m *DelDeviceReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelDeviceReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelDeviceReply proto.InternalMessageInfo
+
+func (m *DelDeviceReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+// Subject
+type SubjectDetail struct {
+	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Owners               []string `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners,omitempty"`
+	MaxDurationSec       int64    `protobuf:"varint,5,opt,name=maxDurationSec,proto3" json:"maxDurationSec,omitempty"`
+	DefaultDurationSec   int64    `protobuf:"varint,6,opt,name=defaultDurationSec,proto3" json:"defaultDurationSec,omitempty"`
+	RiskLevel            string   `protobuf:"bytes,7,opt,name=riskLevel,proto3" json:"riskLevel,omitempty"`
+	Workflow             string   `protobuf:"bytes,8,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubjectDetail) Reset()         { *m = SubjectDetail{} }
+func (m *SubjectDetail) String() string { return proto.CompactTextString(m) }
+func (*SubjectDetail) ProtoMessage()    {}
+func (*SubjectDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{49}
+}
+
+func (m *SubjectDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubjectDetail.Unmarshal(m, b)
+}
+func (m *SubjectDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubjectDetail.Marshal(b, m, deterministic)
+}
+func (m *SubjectDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubjectDetail.Merge(m, src)
+}
+func (m *SubjectDetail) XXX_Size() int {
+	return xxx_messageInfo_SubjectDetail.Size(m)
+}
+func (m *SubjectDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubjectDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubjectDetail proto.InternalMessageInfo
+
+func (m *SubjectDetail) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *SubjectDetail) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SubjectDetail) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SubjectDetail) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *SubjectDetail) GetMaxDurationSec() int64 {
+	if m != nil {
+		return m.MaxDurationSec
+	}
+	return 0
+}
+
+func (m *SubjectDetail) GetDefaultDurationSec() int64 {
+	if m != nil {
+		return m.DefaultDurationSec
+	}
+	return 0
+}
+
+func (m *SubjectDetail) GetRiskLevel() string {
+	if m != nil {
+		return m.RiskLevel
+	}
+	return ""
+}
+
+func (m *SubjectDetail) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+type AddSubjectReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Owners               []string `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	MaxDurationSec       int64    `protobuf:"varint,4,opt,name=maxDurationSec,proto3" json:"maxDurationSec,omitempty"`
+	DefaultDurationSec   int64    `protobuf:"varint,5,opt,name=defaultDurationSec,proto3" json:"defaultDurationSec,omitempty"`
+	RiskLevel            string   `protobuf:"bytes,6,opt,name=riskLevel,proto3" json:"riskLevel,omitempty"`
+	Workflow             string   `protobuf:"bytes,7,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddSubjectReq) Reset()         { *m = AddSubjectReq{} }
+func (m *AddSubjectReq) String() string { return proto.CompactTextString(m) }
+func (*AddSubjectReq) ProtoMessage()    {}
+func (*AddSubjectReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{50}
+}
+
+func (m *AddSubjectReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddSubjectReq.Unmarshal(m, b)
+}
+func (m *AddSubjectReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddSubjectReq.Marshal(b, m, deterministic)
+}
+func (m *AddSubjectReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSubjectReq.Merge(m, src)
+}
+func (m *AddSubjectReq) XXX_Size() int {
+	return xxx_messageInfo_AddSubjectReq.Size(m)
+}
+func (m *AddSubjectReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSubjectReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSubjectReq proto.InternalMessageInfo
+
+func (m *AddSubjectReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddSubjectReq) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AddSubjectReq) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *AddSubjectReq) GetMaxDurationSec() int64 {
+	if m != nil {
+		return m.MaxDurationSec
+	}
+	return 0
+}
+
+func (m *AddSubjectReq) GetDefaultDurationSec() int64 {
+	if m != nil {
+		return m.DefaultDurationSec
+	}
+	return 0
+}
+
+func (m *AddSubjectReq) GetRiskLevel() string {
+	if m != nil {
+		return m.RiskLevel
+	}
+	return ""
+}
+
+func (m *AddSubjectReq) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+type AddSubjectReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ID                   int32    `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddSubjectReply) Reset()         { *m = AddSubjectReply{} }
+func (m *AddSubjectReply) String() string { return proto.CompactTextString(m) }
+func (*AddSubjectReply) ProtoMessage()    {}
+func (*AddSubjectReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{51}
+}
+
+func (m *AddSubjectReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddSubjectReply.Unmarshal(m, b)
+}
+func (m *AddSubjectReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddSubjectReply.Marshal(b, m, deterministic)
+}
+func (m *AddSubjectReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSubjectReply.Merge(m, src)
+}
+func (m *AddSubjectReply) XXX_Size() int {
+	return xxx_messageInfo_AddSubjectReply.Size(m)
+}
+func (m *AddSubjectReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSubjectReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSubjectReply proto.InternalMessageInfo
+
+func (m *AddSubjectReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *AddSubjectReply) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+// empty fields are left unchanged
+type UpdateSubjectReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Owners               []string `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	MaxDurationSec       int64    `protobuf:"varint,4,opt,name=maxDurationSec,proto3" json:"maxDurationSec,omitempty"`
+	DefaultDurationSec   int64    `protobuf:"varint,5,opt,name=defaultDurationSec,proto3" json:"defaultDurationSec,omitempty"`
+	RiskLevel            string   `protobuf:"bytes,6,opt,name=riskLevel,proto3" json:"riskLevel,omitempty"`
+	Workflow             string   `protobuf:"bytes,7,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	ClearWorkflow        bool     `protobuf:"varint,8,opt,name=clearWorkflow,proto3" json:"clearWorkflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSubjectReq) Reset()         { *m = UpdateSubjectReq{} }
+func (m *UpdateSubjectReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateSubjectReq) ProtoMessage()    {}
+func (*UpdateSubjectReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{52}
+}
+
+func (m *UpdateSubjectReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSubjectReq.Unmarshal(m, b)
+}
+func (m *UpdateSubjectReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSubjectReq.Marshal(b, m, deterministic)
+}
+func (m *UpdateSubjectReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSubjectReq.Merge(m, src)
+}
+func (m *UpdateSubjectReq) XXX_Size() int {
+	return xxx_messageInfo_UpdateSubjectReq.Size(m)
+}
+func (m *UpdateSubjectReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSubjectReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSubjectReq proto.InternalMessageInfo
+
+func (m *UpdateSubjectReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateSubjectReq) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateSubjectReq) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *UpdateSubjectReq) GetMaxDurationSec() int64 {
+	if m != nil {
+		return m.MaxDurationSec
+	}
+	return 0
+}
+
+func (m *UpdateSubjectReq) GetDefaultDurationSec() int64 {
+	if m != nil {
+		return m.DefaultDurationSec
+	}
+	return 0
+}
+
+func (m *UpdateSubjectReq) GetRiskLevel() string {
+	if m != nil {
+		return m.RiskLevel
+	}
+	return ""
+}
+
+func (m *UpdateSubjectReq) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *UpdateSubjectReq) GetClearWorkflow() bool {
+	if m != nil {
+		return m.ClearWorkflow
+	}
+	return false
+}
+
+type UpdateSubjectReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSubjectReply) Reset()         { *m = UpdateSubjectReply{} }
+func (m *UpdateSubjectReply) String() string { return proto.CompactTextString(m) }
+func (*UpdateSubjectReply) ProtoMessage()    {}
+func (*UpdateSubjectReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{53}
+}
+
+func (m *UpdateSubjectReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSubjectReply.Unmarshal(m, b)
+}
+func (m *UpdateSubjectReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSubjectReply.Marshal(b, m, deterministic)
+}
+func (m *UpdateSubjectReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSubjectReply.Merge(m, src)
+}
+func (m *UpdateSubjectReply) XXX_Size() int {
+	return xxx_messageInfo_UpdateSubjectReply.Size(m)
+}
+func (m *UpdateSubjectReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSubjectReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSubjectReply proto.InternalMessageInfo
+
+func (m *UpdateSubjectReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+type GetSubjectReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSubjectReq) Reset()         { *m = GetSubjectReq{} }
+func (m *GetSubjectReq) String() string { return proto.CompactTextString(m) }
+func (*GetSubjectReq) ProtoMessage()    {}
+func (*GetSubjectReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{54}
+}
+
+func (m *GetSubjectReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSubjectReq.Unmarshal(m, b)
+}
+func (m *GetSubjectReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSubjectReq.Marshal(b, m, deterministic)
+}
+func (m *GetSubjectReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSubjectReq.Merge(m, src)
+}
+func (m *GetSubjectReq) XXX_Size() int {
+	return xxx_messageInfo_GetSubjectReq.Size(m)
+}
+func (m *GetSubjectReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSubjectReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSubjectReq proto.InternalMessageInfo
+
+func (m *GetSubjectReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListSubjectReq struct {
+	PageIdx              int32    `protobuf:"varint,1,opt,name=pageIdx,proto3" json:"pageIdx,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Search               string   `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Owner                string   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	RiskLevel            string   `protobuf:"bytes,5,opt,name=riskLevel,proto3" json:"riskLevel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSubjectReq) Reset()         { *m = ListSubjectReq{} }
+func (m *ListSubjectReq) String() string { return proto.CompactTextString(m) }
+func (*ListSubjectReq) ProtoMessage()    {}
+func (*ListSubjectReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{55}
+}
+
+func (m *ListSubjectReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubjectReq.Unmarshal(m, b)
+}
+func (m *ListSubjectReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubjectReq.Marshal(b, m, deterministic)
+}
+func (m *ListSubjectReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubjectReq.Merge(m, src)
+}
+func (m *ListSubjectReq) XXX_Size() int {
+	return xxx_messageInfo_ListSubjectReq.Size(m)
+}
+func (m *ListSubjectReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubjectReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubjectReq proto.InternalMessageInfo
+
+func (m *ListSubjectReq) GetPageIdx() int32 {
+	if m != nil {
+		return m.PageIdx
+	}
+	return 0
+}
+
+func (m *ListSubjectReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListSubjectReq) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ListSubjectReq) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ListSubjectReq) GetRiskLevel() string {
+	if m != nil {
+		return m.RiskLevel
+	}
+	return ""
+}
+
+type ListSubjectReply struct {
+	Page                 *ModelPage       `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Record               []*SubjectDetail `protobuf:"bytes,2,rep,name=record,proto3" json:"record,omitempty"`
+	Exhausted            bool             `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListSubjectReply) Reset()         { *m = ListSubjectReply{} }
+func (m *ListSubjectReply) String() string { return proto.CompactTextString(m) }
+func (*ListSubjectReply) ProtoMessage()    {}
+func (*ListSubjectReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{56}
+}
+
+func (m *ListSubjectReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubjectReply.Unmarshal(m, b)
+}
+func (m *ListSubjectReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubjectReply.Marshal(b, m, deterministic)
+}
+func (m *ListSubjectReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubjectReply.Merge(m, src)
+}
+func (m *ListSubjectReply) XXX_Size() int {
+	return xxx_messageInfo_ListSubjectReply.Size(m)
+}
+func (m *ListSubjectReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubjectReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubjectReply proto.InternalMessageInfo
+
+func (m *ListSubjectReply) GetPage() *ModelPage {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *ListSubjectReply) GetRecord() []*SubjectDetail {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *ListSubjectReply) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
+	}
+	return false
+}
+
+type DelSubjectReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelSubjectReq) Reset()         { *m = DelSubjectReq{} }
+func (m *DelSubjectReq) String() string { return proto.CompactTextString(m) }
+func (*DelSubjectReq) ProtoMessage()    {}
+func (*DelSubjectReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{57}
+}
+
+func (m *DelSubjectReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelSubjectReq.Unmarshal(m, b)
+}
+func (m *DelSubjectReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelSubjectReq.Marshal(b, m, deterministic)
+}
+func (m *DelSubjectReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelSubjectReq.Merge(m, src)
+}
+func (m *DelSubjectReq) XXX_Size() int {
+	return xxx_messageInfo_DelSubjectReq.Size(m)
+}
+func (m *DelSubjectReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelSubjectReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelSubjectReq proto.InternalMessageInfo
+
+func (m *DelSubjectReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DelSubjectReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelSubjectReply) Reset()         { *m = DelSubjectReply{} }
+func (m *DelSubjectReply) String() string { return proto.CompactTextString(m) }
+func (*DelSubjectReply) ProtoMessage()    {}
+func (*DelSubjectReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1659b64737a352e6, []int{58}
+}
+
+func (m *DelSubjectReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelSubjectReply.Unmarshal(m, b)
+}
+func (m *DelSubjectReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelSubjectReply.Marshal(b, m, deterministic)
+}
+func (m *DelSubjectReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelSubjectReply.Merge(m, src)
+}
+func (m *DelSubjectReply) XXX_Size() int {
+	return xxx_messageInfo_DelSubjectReply.Size(m)
+}
+func (m *DelSubjectReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelSubjectReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelSubjectReply proto.InternalMessageInfo
+
+func (m *DelSubjectReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ModelPage)(nil), "InfraApply.ModelPage")
+	proto.RegisterType((*ListInfraApplyReq)(nil), "InfraApply.ListInfraApplyReq")
+	proto.RegisterType((*ListInfraApplyReply)(nil), "InfraApply.ListInfraApplyReply")
+	proto.RegisterType((*DetailInfraApplyReply)(nil), "InfraApply.DetailInfraApplyReply")
+	proto.RegisterType((*SearchInfraApplyReq)(nil), "InfraApply.SearchInfraApplyReq")
+	proto.RegisterType((*SearchInfraApplyHit)(nil), "InfraApply.SearchInfraApplyHit")
+	proto.RegisterMapType((map[string]string)(nil), "InfraApply.SearchInfraApplyHit.HighlightsEntry")
+	proto.RegisterType((*SearchInfraApplyReply)(nil), "InfraApply.SearchInfraApplyReply")
+	proto.RegisterType((*GetInfraApplyStatsReq)(nil), "InfraApply.GetInfraApplyStatsReq")
+	proto.RegisterType((*InfraApplyStatsGroup)(nil), "InfraApply.InfraApplyStatsGroup")
+	proto.RegisterType((*GetInfraApplyStatsReply)(nil), "InfraApply.GetInfraApplyStatsReply")
+	proto.RegisterType((*GetInfraApplyReq)(nil), "InfraApply.GetInfraApplyReq")
+	proto.RegisterType((*AddInfraApplyReq)(nil), "InfraApply.AddInfraApplyReq")
+	proto.RegisterType((*AddInfraApplyReply)(nil), "InfraApply.AddInfraApplyReply")
+	proto.RegisterType((*UpdateInfraApplyReq)(nil), "InfraApply.UpdateInfraApplyReq")
+	proto.RegisterType((*InfraApplyPatch)(nil), "InfraApply.InfraApplyPatch")
+	proto.RegisterType((*ExtendInfraApplyReq)(nil), "InfraApply.ExtendInfraApplyReq")
+	proto.RegisterType((*ExtendInfraApplyReply)(nil), "InfraApply.ExtendInfraApplyReply")
+	proto.RegisterType((*UpdateInfraApplyReply)(nil), "InfraApply.UpdateInfraApplyReply")
+	proto.RegisterType((*BatchUpdateInfraApplyReq)(nil), "InfraApply.BatchUpdateInfraApplyReq")
+	proto.RegisterType((*BatchUpdateItem)(nil), "InfraApply.BatchUpdateItem")
+	proto.RegisterType((*BatchUpdateInfraApplyReply)(nil), "InfraApply.BatchUpdateInfraApplyReply")
+	proto.RegisterType((*ListInfraApplyReviewReq)(nil), "InfraApply.ListInfraApplyReviewReq")
+	proto.RegisterType((*InfraApplyReviewDetail)(nil), "InfraApply.InfraApplyReviewDetail")
+	proto.RegisterType((*ListInfraApplyReviewReply)(nil), "InfraApply.ListInfraApplyReviewReply")
+	proto.RegisterType((*WorkflowStage)(nil), "InfraApply.WorkflowStage")
+	proto.RegisterType((*WorkflowDetail)(nil), "InfraApply.WorkflowDetail")
+	proto.RegisterType((*SaveWorkflowReq)(nil), "InfraApply.SaveWorkflowReq")
+	proto.RegisterType((*SaveWorkflowReply)(nil), "InfraApply.SaveWorkflowReply")
+	proto.RegisterType((*ListWorkflowReq)(nil), "InfraApply.ListWorkflowReq")
+	proto.RegisterType((*ListWorkflowReply)(nil), "InfraApply.ListWorkflowReply")
+	proto.RegisterType((*SimulatePolicyReq)(nil), "InfraApply.SimulatePolicyReq")
+	proto.RegisterType((*PolicyRuleResult)(nil), "InfraApply.PolicyRuleResult")
+	proto.RegisterType((*SimulatePolicyReply)(nil), "InfraApply.SimulatePolicyReply")
+	proto.RegisterType((*ImportInfraApplyRow)(nil), "InfraApply.ImportInfraApplyRow")
+	proto.RegisterType((*ImportInfraApplyReq)(nil), "InfraApply.ImportInfraApplyReq")
+	proto.RegisterType((*ImportRowError)(nil), "InfraApply.ImportRowError")
+	proto.RegisterType((*ImportInfraApplyReply)(nil), "InfraApply.ImportInfraApplyReply")
+	proto.RegisterType((*DelInfraApplyReq)(nil), "InfraApply.DelInfraApplyReq")
+	proto.RegisterType((*DelInfraApplyReply)(nil), "InfraApply.DelInfraApplyReply")
+	proto.RegisterType((*DeviceDetail)(nil), "InfraApply.DeviceDetail")
+	proto.RegisterType((*AddDeviceReq)(nil), "InfraApply.AddDeviceReq")
+	proto.RegisterType((*AddDeviceReply)(nil), "InfraApply.AddDeviceReply")
+	proto.RegisterType((*UpdateDeviceReq)(nil), "InfraApply.UpdateDeviceReq")
+	proto.RegisterType((*UpdateDeviceReply)(nil), "InfraApply.UpdateDeviceReply")
+	proto.RegisterType((*GetDeviceReq)(nil), "InfraApply.GetDeviceReq")
+	proto.RegisterType((*ListDeviceReq)(nil), "InfraApply.ListDeviceReq")
+	proto.RegisterType((*ListDeviceReply)(nil), "InfraApply.ListDeviceReply")
+	proto.RegisterType((*DelDeviceReq)(nil), "InfraApply.DelDeviceReq")
+	proto.RegisterType((*DelDeviceReply)(nil), "InfraApply.DelDeviceReply")
+	proto.RegisterType((*SubjectDetail)(nil), "InfraApply.SubjectDetail")
+	proto.RegisterType((*AddSubjectReq)(nil), "InfraApply.AddSubjectReq")
+	proto.RegisterType((*AddSubjectReply)(nil), "InfraApply.AddSubjectReply")
+	proto.RegisterType((*UpdateSubjectReq)(nil), "InfraApply.UpdateSubjectReq")
+	proto.RegisterType((*UpdateSubjectReply)(nil), "InfraApply.UpdateSubjectReply")
+	proto.RegisterType((*GetSubjectReq)(nil), "InfraApply.GetSubjectReq")
+	proto.RegisterType((*ListSubjectReq)(nil), "InfraApply.ListSubjectReq")
+	proto.RegisterType((*ListSubjectReply)(nil), "InfraApply.ListSubjectReply")
+	proto.RegisterType((*DelSubjectReq)(nil), "InfraApply.DelSubjectReq")
+	proto.RegisterType((*DelSubjectReply)(nil), "InfraApply.DelSubjectReply")
+}
+
+func init() { proto.RegisterFile("microservcice.proto", fileDescriptor_1659b64737a352e6) }
+
+var fileDescriptor_1659b64737a352e6 = []byte{
+	// 2905 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0xdc, 0xc6,
+	0x15, 0x06, 0xf7, 0x4f, 0xd2, 0x93, 0x25, 0xad, 0x29, 0xc9, 0x59, 0xd3, 0xf2, 0xdf, 0xd8, 0x49,
+	0x6c, 0xc5, 0x90, 0x2c, 0xb5, 0x4d, 0x62, 0x05, 0x41, 0x21, 0x5b, 0x8a, 0xb2, 0x45, 0x94, 0x08,
+	0x94, 0x13, 0xa3, 0xb9, 0x04, 0xf4, 0x72, 0x24, 0x31, 0xe2, 0x2e, 0xd7, 0x24, 0x57, 0xb2, 0x12,
+	0xf4, 0x92, 0xde, 0x8a, 0xb4, 0x68, 0x13, 0xa4, 0x48, 0x7f, 0x51, 0x14, 0x08, 0xda, 0x43, 0x81,
+	0x02, 0xed, 0xa9, 0x87, 0xde, 0x7b, 0xe8, 0xa5, 0xe8, 0xad, 0x97, 0x5e, 0x7a, 0xef, 0xa5, 0xf7,
+	0x14, 0x6f, 0x66, 0x48, 0xce, 0xcc, 0x92, 0x94, 0x64, 0x04, 0xee, 0xa1, 0xb7, 0x7d, 0x9c, 0x37,
+	0x7c, 0xdf, 0xfb, 0x9d, 0x37, 0x8f, 0x0b, 0xd3, 0x5d, 0xaf, 0x13, 0x06, 0x11, 0x0d, 0x0f, 0x3a,
+	0x5e, 0x87, 0x2e, 0xf4, 0xc3, 0x20, 0x0e, 0x4c, 0x68, 0xf7, 0x76, 0x42, 0x67, 0xb5, 0xdf, 0xf7,
+	0x8f, 0xac, 0xb9, 0xdd, 0x20, 0xd8, 0xf5, 0xe9, 0xa2, 0xd3, 0xf7, 0x16, 0x9d, 0x5e, 0x2f, 0x88,
+	0x9d, 0xd8, 0x0b, 0x7a, 0x11, 0xe7, 0xb4, 0xae, 0x88, 0x55, 0x46, 0x3d, 0x1c, 0xec, 0x2c, 0xee,
+	0x78, 0xd4, 0x77, 0xdf, 0xeb, 0x3a, 0xd1, 0x3e, 0xe7, 0x20, 0x0f, 0x60, 0x6c, 0x33, 0x70, 0xa9,
+	0xbf, 0xe5, 0xec, 0x52, 0xb3, 0x05, 0x23, 0x7d, 0x67, 0x97, 0xb6, 0xdd, 0xc7, 0x2d, 0xe3, 0x8a,
+	0x71, 0xa3, 0x6e, 0x27, 0xa4, 0x69, 0xc1, 0x28, 0xfe, 0xdc, 0xf6, 0x3e, 0xa0, 0xad, 0x0a, 0x5b,
+	0x4a, 0x69, 0x73, 0x06, 0xea, 0x71, 0x10, 0x3b, 0x7e, 0xab, 0xca, 0x16, 0x38, 0x41, 0xfe, 0x66,
+	0xc0, 0xd9, 0x37, 0xbc, 0x28, 0xce, 0xb0, 0xda, 0xf4, 0xd1, 0x13, 0x4a, 0x38, 0x07, 0x8d, 0x88,
+	0x3a, 0x61, 0x67, 0x8f, 0x89, 0x18, 0xb3, 0x05, 0x65, 0xce, 0xc1, 0x18, 0x7d, 0x1c, 0xd3, 0x9e,
+	0x1b, 0xb5, 0xd7, 0x5a, 0x35, 0xb6, 0x29, 0x7b, 0xc0, 0x76, 0xc5, 0x4e, 0x3c, 0x88, 0x5a, 0x75,
+	0xb1, 0x8b, 0x51, 0x88, 0xc1, 0x41, 0x3c, 0x34, 0x6c, 0x35, 0xd8, 0x42, 0x42, 0x9a, 0x97, 0x00,
+	0x5c, 0x7a, 0xe0, 0x75, 0xe8, 0xbd, 0xc0, 0xa5, 0xad, 0x11, 0xb6, 0x28, 0x3d, 0x21, 0x3f, 0x31,
+	0x60, 0x5a, 0xd7, 0xa9, 0xef, 0x1f, 0x99, 0x37, 0xa1, 0x86, 0x58, 0x99, 0x4a, 0xe3, 0xcb, 0xb3,
+	0x0b, 0xd9, 0xfa, 0x42, 0x6a, 0x5c, 0x9b, 0xb1, 0x98, 0x77, 0xa0, 0x11, 0xd2, 0x4e, 0x10, 0xba,
+	0xad, 0xca, 0x95, 0xea, 0x8d, 0xf1, 0xe5, 0xab, 0x32, 0xf3, 0x1a, 0x8d, 0x1d, 0xcf, 0xd7, 0xde,
+	0x6e, 0x8b, 0x0d, 0x5c, 0xdb, 0x3d, 0x67, 0x10, 0xc5, 0xd4, 0x65, 0x86, 0x18, 0xb5, 0xb3, 0x07,
+	0xe4, 0xf7, 0x55, 0x98, 0xcd, 0xdd, 0x6f, 0x4e, 0x42, 0xa5, 0xbd, 0x26, 0xcc, 0x5d, 0x69, 0xaf,
+	0x69, 0x5a, 0x56, 0x74, 0x2d, 0x65, 0xfb, 0x54, 0x55, 0xfb, 0x64, 0x16, 0xad, 0x29, 0x16, 0xbd,
+	0x02, 0xe3, 0xd1, 0xe0, 0xe1, 0xfb, 0xb4, 0x13, 0xbf, 0xe9, 0x74, 0xa9, 0x30, 0xb7, 0xfc, 0x08,
+	0xbd, 0x1b, 0xd2, 0x03, 0x8f, 0x1e, 0xb6, 0x5d, 0x61, 0xf4, 0x94, 0xc6, 0x35, 0xfa, 0xb8, 0xef,
+	0x85, 0xf4, 0xfe, 0xa6, 0xb0, 0x79, 0x4a, 0x67, 0xfb, 0xee, 0x6f, 0xb6, 0x46, 0xe5, 0x7d, 0xf7,
+	0x37, 0x11, 0x67, 0x27, 0xe8, 0x76, 0x69, 0x2f, 0x6e, 0x8d, 0x71, 0x9c, 0x82, 0x44, 0x0d, 0x0f,
+	0x83, 0x70, 0x7f, 0xc7, 0x0f, 0x0e, 0xdb, 0x6b, 0x2d, 0x60, 0x9a, 0x4b, 0x4f, 0x30, 0x62, 0xa3,
+	0x18, 0x1d, 0x36, 0xce, 0x23, 0x96, 0x11, 0xb8, 0xab, 0x1f, 0xf8, 0x5e, 0xe7, 0xc8, 0x1e, 0xf8,
+	0xb4, 0x75, 0x86, 0xdb, 0x25, 0x7b, 0x62, 0xde, 0x86, 0x06, 0xb7, 0x52, 0x6b, 0x82, 0xf9, 0xb9,
+	0xa5, 0xba, 0x0e, 0x57, 0xb8, 0x03, 0x6c, 0xc1, 0xa7, 0xc6, 0xe7, 0xa4, 0x16, 0x9f, 0xe4, 0x8f,
+	0x06, 0x4c, 0x6f, 0xb3, 0x40, 0x56, 0x73, 0x64, 0x06, 0xea, 0x8f, 0x06, 0x34, 0x3c, 0x62, 0x2e,
+	0x1b, 0xb3, 0x39, 0x21, 0x67, 0x4e, 0xa5, 0x38, 0x73, 0xaa, 0x39, 0x99, 0x93, 0xe7, 0x31, 0xc9,
+	0xc7, 0xf5, 0xb2, 0x1c, 0x68, 0x0c, 0xe5, 0xc0, 0x7f, 0x72, 0x50, 0xbf, 0xee, 0xc5, 0xe6, 0x4b,
+	0x50, 0x67, 0xaf, 0x10, 0x49, 0x70, 0x82, 0xb8, 0xe6, 0xfc, 0xcc, 0x19, 0x9d, 0x20, 0xe4, 0x91,
+	0x68, 0xd8, 0x9c, 0x30, 0xdf, 0x02, 0xd8, 0xf3, 0x76, 0xf7, 0x7c, 0x6f, 0x77, 0x2f, 0x8e, 0x5a,
+	0x55, 0x96, 0x2b, 0x8b, 0xf2, 0x3b, 0x73, 0x30, 0x2c, 0xbc, 0x9e, 0xee, 0x58, 0xef, 0xc5, 0xe1,
+	0x91, 0x2d, 0xbd, 0xc2, 0x7a, 0x15, 0xa6, 0xb4, 0x65, 0xb3, 0x09, 0xd5, 0x7d, 0x9a, 0x98, 0x19,
+	0x7f, 0x22, 0x96, 0x03, 0xc7, 0x1f, 0x24, 0x59, 0xc1, 0x89, 0x95, 0xca, 0xcb, 0x06, 0xf9, 0xd4,
+	0x80, 0xd9, 0x61, 0x67, 0x9d, 0x32, 0xf9, 0x97, 0xa0, 0xba, 0xe7, 0xc5, 0x22, 0xf3, 0x2f, 0x1f,
+	0xa3, 0x8d, 0x8d, 0xbc, 0xc7, 0x24, 0xfd, 0x97, 0x06, 0xcc, 0x6e, 0x50, 0xa9, 0x1e, 0x6d, 0xc7,
+	0x4e, 0x1c, 0x89, 0x42, 0xbb, 0x1b, 0x06, 0x83, 0xfe, 0x5d, 0xd4, 0xaf, 0x8a, 0x0e, 0x16, 0x24,
+	0x86, 0xc4, 0xc3, 0x41, 0x67, 0x9f, 0xc6, 0x42, 0x49, 0x41, 0xe1, 0x8e, 0x9d, 0x30, 0xe8, 0xae,
+	0x39, 0x47, 0x49, 0xda, 0x0b, 0x92, 0x17, 0x78, 0x7c, 0xce, 0x63, 0x88, 0x13, 0x85, 0xe5, 0x55,
+	0x2b, 0x06, 0x8d, 0xe1, 0x62, 0x20, 0x05, 0xdf, 0x88, 0x1a, 0x7c, 0x69, 0xba, 0xd3, 0x50, 0x4d,
+	0x77, 0x1a, 0x22, 0x0a, 0xdf, 0xeb, 0x7a, 0x3c, 0xd9, 0xeb, 0x36, 0x27, 0xc8, 0x3f, 0x2a, 0x30,
+	0xa3, 0xa9, 0xbf, 0x81, 0x8a, 0x4a, 0x6a, 0x1a, 0x8a, 0x9a, 0x19, 0xec, 0x4a, 0x19, 0xec, 0x6a,
+	0x29, 0xec, 0x5a, 0x31, 0xec, 0xfa, 0x30, 0x6c, 0x7e, 0x3a, 0xa2, 0x21, 0xaa, 0xe2, 0x74, 0x44,
+	0x14, 0x4e, 0x27, 0xf6, 0x0e, 0xf8, 0x29, 0x53, 0xb5, 0x05, 0x25, 0xbd, 0xc9, 0x65, 0x06, 0xa8,
+	0xa6, 0x6f, 0x72, 0xcd, 0xeb, 0x30, 0xe1, 0x3b, 0x31, 0xed, 0x75, 0x8e, 0xb6, 0xbe, 0x71, 0x7b,
+	0x9b, 0x76, 0x98, 0x21, 0x0c, 0x5b, 0x7d, 0x28, 0x73, 0xdd, 0x61, 0x5c, 0xa0, 0x72, 0xdd, 0x19,
+	0xe2, 0xba, 0x83, 0x5c, 0xe3, 0x1a, 0x17, 0x3e, 0x24, 0xdf, 0x37, 0xe0, 0x99, 0xbc, 0xf0, 0xc2,
+	0xb0, 0x7f, 0x11, 0xea, 0x2c, 0xa2, 0x58, 0x78, 0x8d, 0x2f, 0x5f, 0x91, 0xa3, 0x39, 0xcf, 0x21,
+	0x36, 0x67, 0xc7, 0x80, 0x0e, 0xe9, 0x4e, 0x48, 0xa3, 0xbd, 0xfb, 0x9b, 0xc2, 0x05, 0xd9, 0x03,
+	0x5c, 0x8d, 0xc3, 0x41, 0xaf, 0xe3, 0x48, 0xe1, 0x9e, 0x3e, 0x20, 0x04, 0x9a, 0x0a, 0x1c, 0x0c,
+	0x74, 0xed, 0x74, 0x23, 0x1f, 0x19, 0xd0, 0x5c, 0x75, 0x5d, 0x95, 0x49, 0x2d, 0x6a, 0xc6, 0xd0,
+	0x91, 0xd7, 0x84, 0xea, 0xc0, 0x73, 0x05, 0x1c, 0xfc, 0x79, 0x82, 0x70, 0x90, 0x8f, 0xad, 0x9a,
+	0x7a, 0x6c, 0x91, 0x18, 0x4c, 0x0d, 0x03, 0x9a, 0xec, 0x1c, 0x9e, 0xfd, 0xd1, 0xc0, 0x4f, 0x43,
+	0x92, 0x53, 0x42, 0x85, 0x4a, 0x7a, 0x40, 0x67, 0x21, 0x5a, 0x55, 0x42, 0x54, 0x3d, 0xa0, 0x6a,
+	0xfa, 0x01, 0x45, 0xfe, 0x62, 0xc0, 0xf4, 0xdb, 0x7d, 0xd7, 0x89, 0x69, 0xa9, 0x89, 0x0a, 0x53,
+	0x40, 0xd6, 0xa8, 0xaa, 0x1d, 0xc4, 0x4b, 0x49, 0x79, 0xaf, 0xb1, 0x32, 0x77, 0x21, 0xdf, 0xdd,
+	0x5b, 0x4e, 0xdc, 0xd9, 0x4b, 0x0a, 0xfb, 0x0a, 0xc0, 0x80, 0xa1, 0xd9, 0x74, 0xa2, 0x7d, 0x96,
+	0x17, 0xe3, 0xcb, 0xd6, 0x02, 0xef, 0x48, 0x17, 0x92, 0x8e, 0x74, 0xe1, 0x35, 0xec, 0x48, 0x91,
+	0xc3, 0x96, 0xb8, 0xc9, 0x7b, 0x30, 0xa5, 0xbd, 0x55, 0x42, 0x6d, 0x14, 0xa2, 0xae, 0x68, 0xa8,
+	0xa5, 0x16, 0xa1, 0xaa, 0xb4, 0x08, 0xa4, 0x0b, 0xd3, 0xeb, 0xec, 0x24, 0x2e, 0x37, 0xd5, 0x70,
+	0x60, 0x94, 0x19, 0x49, 0x12, 0x57, 0x53, 0xc5, 0x1d, 0xc2, 0xec, 0xb0, 0xb8, 0xa7, 0x11, 0x13,
+	0x1b, 0x30, 0x3b, 0x1c, 0x12, 0x65, 0x82, 0x0b, 0x82, 0x83, 0xfc, 0xba, 0x02, 0xad, 0xbb, 0xe8,
+	0x88, 0xbc, 0x08, 0x6b, 0x42, 0xb5, 0xbd, 0x16, 0xb1, 0x52, 0x50, 0xb7, 0xf1, 0xa7, 0xd4, 0xb2,
+	0x57, 0x94, 0x96, 0xbd, 0x48, 0x8f, 0x92, 0x6c, 0x92, 0xcd, 0x5a, 0x1f, 0x6a, 0xf4, 0x1e, 0xd2,
+	0x28, 0x5e, 0xdf, 0xd9, 0x09, 0xc2, 0x98, 0x55, 0xd8, 0x51, 0x5b, 0x7a, 0x82, 0xd2, 0xdc, 0xf0,
+	0xc8, 0x1e, 0xf4, 0x58, 0x99, 0x1d, 0xb5, 0x05, 0x25, 0x97, 0xf2, 0xd1, 0xb2, 0xf6, 0x67, 0x6c,
+	0xa8, 0x52, 0x28, 0x2d, 0x1d, 0xe8, 0x2d, 0xdd, 0x5b, 0x30, 0x25, 0xdb, 0x28, 0xa6, 0xdd, 0xbc,
+	0xe4, 0x13, 0x76, 0xaf, 0x28, 0x76, 0x9f, 0x81, 0x3a, 0x0d, 0xc3, 0x20, 0xe9, 0xb9, 0x39, 0x41,
+	0x7e, 0x61, 0x80, 0x55, 0x60, 0xf5, 0x32, 0x27, 0x2e, 0x41, 0xdd, 0x8b, 0x69, 0x37, 0x12, 0xad,
+	0x86, 0x92, 0xad, 0x1a, 0x40, 0x9b, 0x73, 0xa2, 0x62, 0xd1, 0xa0, 0xd3, 0xa1, 0xd4, 0x15, 0x95,
+	0xb7, 0x6e, 0x67, 0x0f, 0x50, 0xd0, 0x8e, 0xe3, 0xf9, 0xd4, 0x15, 0xd7, 0x2c, 0x41, 0x91, 0x9b,
+	0xf0, 0x8c, 0x7e, 0x21, 0xc2, 0xd3, 0x2a, 0xaf, 0x30, 0xff, 0xdc, 0x80, 0x73, 0x3a, 0x1f, 0x6f,
+	0x0c, 0xb3, 0x7e, 0xdc, 0x90, 0xfb, 0x71, 0xf9, 0x54, 0xad, 0x68, 0xa7, 0xaa, 0x05, 0xa3, 0x2e,
+	0xed, 0x78, 0x91, 0x17, 0xf4, 0x92, 0x2c, 0x4c, 0xe8, 0xe2, 0x2c, 0x54, 0x6e, 0x13, 0x75, 0xf5,
+	0x36, 0x41, 0x1e, 0xc0, 0xf9, 0x7c, 0x4d, 0x78, 0x29, 0x4b, 0x6e, 0x6d, 0xfc, 0xb4, 0x23, 0xf9,
+	0xe5, 0x4f, 0x56, 0x2a, 0xb9, 0xb6, 0x91, 0x7d, 0x98, 0x78, 0x20, 0xae, 0x1e, 0xdb, 0x4c, 0xaf,
+	0x26, 0x54, 0x23, 0xfa, 0x48, 0xe8, 0x8a, 0x3f, 0x4d, 0x13, 0x6a, 0x3d, 0x3c, 0x65, 0xb8, 0x96,
+	0xec, 0x37, 0x3f, 0x27, 0xb9, 0xb6, 0xbc, 0xff, 0x1d, 0xb3, 0xb3, 0x07, 0xe8, 0x8f, 0x47, 0x83,
+	0x20, 0x1c, 0x74, 0x13, 0x7f, 0x70, 0x8a, 0x7c, 0x61, 0xc0, 0x64, 0x22, 0x4d, 0x18, 0x57, 0x0f,
+	0xc0, 0x3c, 0x61, 0x57, 0x60, 0xdc, 0xa5, 0x51, 0x27, 0xf4, 0xfa, 0x71, 0x66, 0x51, 0xf9, 0x91,
+	0xb9, 0xc4, 0xf2, 0x76, 0x97, 0xe2, 0x45, 0x02, 0x2d, 0x70, 0x5e, 0xb6, 0x80, 0xa2, 0x9f, 0x2d,
+	0x18, 0xd1, 0xda, 0xe2, 0xbc, 0xc4, 0x16, 0x11, 0x15, 0x48, 0x69, 0xf2, 0x63, 0x03, 0xa6, 0xb6,
+	0x9d, 0x03, 0x9a, 0xec, 0xb4, 0x25, 0x2b, 0x18, 0xc5, 0xc0, 0x2a, 0x65, 0xc0, 0xaa, 0x4f, 0x02,
+	0xac, 0xa6, 0x01, 0x7b, 0x05, 0xce, 0xaa, 0xb8, 0x4e, 0x51, 0xa4, 0xc9, 0x06, 0x4c, 0x61, 0x0c,
+	0xc9, 0x4a, 0x3d, 0xd1, 0xc0, 0x83, 0x84, 0x70, 0x56, 0x7d, 0xd1, 0x29, 0x2f, 0x1a, 0xcb, 0xda,
+	0x94, 0xc1, 0xca, 0x33, 0x8a, 0x16, 0xa7, 0x3f, 0x33, 0xe0, 0xec, 0xb6, 0xd7, 0x1d, 0x60, 0x0b,
+	0xb8, 0xc5, 0x0f, 0x90, 0xa7, 0xdf, 0x39, 0xa1, 0xa9, 0xe9, 0x81, 0xe3, 0xa7, 0x09, 0x2a, 0x28,
+	0xf2, 0x0e, 0x34, 0xb7, 0xd2, 0x53, 0xcd, 0xe6, 0xe6, 0xcf, 0x0b, 0x98, 0x16, 0x8c, 0x74, 0xb1,
+	0xc0, 0x51, 0x8e, 0x69, 0xd4, 0x4e, 0xc8, 0x82, 0x02, 0xfb, 0x19, 0x5e, 0x67, 0x35, 0xad, 0xd1,
+	0xd8, 0x26, 0xd4, 0xc2, 0x81, 0x9f, 0xbe, 0x1b, 0x7f, 0x27, 0x4d, 0x7b, 0x1a, 0x87, 0x82, 0x42,
+	0x7d, 0x92, 0xe1, 0x42, 0x52, 0x8c, 0x12, 0xda, 0x7c, 0x11, 0x46, 0x78, 0xb0, 0x24, 0x89, 0x33,
+	0x27, 0xbb, 0x42, 0x57, 0xc9, 0x4e, 0x98, 0xc9, 0x77, 0x2b, 0x30, 0xdd, 0xee, 0xf6, 0x83, 0x50,
+	0xae, 0x48, 0xc1, 0x21, 0xe2, 0xf2, 0xbd, 0x5e, 0x52, 0x29, 0xd9, 0xef, 0x63, 0x07, 0x3a, 0xc2,
+	0x47, 0xd5, 0x42, 0x1f, 0xd5, 0x86, 0x7d, 0x54, 0x74, 0xbb, 0x93, 0x7d, 0xd7, 0x28, 0x1a, 0xd6,
+	0xb4, 0xdd, 0x64, 0x90, 0x23, 0x0f, 0x79, 0x4e, 0x3f, 0xc8, 0x21, 0x8f, 0x73, 0x8c, 0x40, 0x1f,
+	0x49, 0xc7, 0xba, 0xa1, 0x1c, 0xeb, 0xe7, 0xa0, 0x31, 0xe8, 0x47, 0x34, 0x8c, 0x85, 0xef, 0x05,
+	0x85, 0xf7, 0xee, 0x50, 0xf8, 0x46, 0xbb, 0x77, 0xe7, 0x98, 0xd8, 0x46, 0x5e, 0xb2, 0x02, 0x93,
+	0x7c, 0xcd, 0x0e, 0x0e, 0xd7, 0x31, 0x52, 0x72, 0x2d, 0x9f, 0xc6, 0x54, 0x45, 0x8e, 0xa9, 0x7f,
+	0x1a, 0x30, 0x3b, 0x0c, 0xbb, 0xac, 0x90, 0xb4, 0x60, 0xe4, 0x21, 0x86, 0xa9, 0xa8, 0x26, 0x63,
+	0x76, 0x42, 0xe6, 0x0f, 0x57, 0x99, 0xc5, 0x42, 0xca, 0x2e, 0x49, 0xbc, 0xfe, 0x27, 0x24, 0xae,
+	0xf0, 0x36, 0xda, 0x65, 0x8e, 0xab, 0xdb, 0x09, 0x29, 0x1d, 0xe1, 0x0d, 0xf9, 0x08, 0xc7, 0x5a,
+	0xc1, 0x60, 0x47, 0xad, 0x91, 0xe1, 0x5a, 0xa1, 0xda, 0xc0, 0x16, 0x9c, 0xe4, 0xeb, 0xd0, 0x5c,
+	0xa3, 0x7e, 0x51, 0xeb, 0x3c, 0x96, 0xdf, 0x3a, 0x93, 0x5b, 0x60, 0x6a, 0xbb, 0x4a, 0x6c, 0x42,
+	0x7e, 0x6b, 0xc0, 0x19, 0x79, 0xaa, 0x96, 0x77, 0x90, 0x75, 0xb2, 0x80, 0x67, 0xbf, 0xd3, 0x92,
+	0x50, 0x95, 0x4a, 0xc2, 0x0c, 0xd4, 0x83, 0xc3, 0x5e, 0x7a, 0x6b, 0xe7, 0x04, 0xa6, 0x00, 0xed,
+	0x1d, 0x78, 0x61, 0xd0, 0x93, 0x1a, 0x4b, 0xf9, 0x11, 0xbe, 0x2b, 0x76, 0x76, 0xa3, 0x56, 0x83,
+	0x1d, 0x11, 0xec, 0xb7, 0xe8, 0x54, 0xe2, 0x64, 0x38, 0xcc, 0x09, 0xf2, 0xb9, 0x01, 0x67, 0x56,
+	0x5d, 0x97, 0xa3, 0x15, 0x47, 0x59, 0x27, 0xab, 0x97, 0x2a, 0xb4, 0x4a, 0x1e, 0xb4, 0x6a, 0x09,
+	0xb4, 0x5a, 0x31, 0xb4, 0x7a, 0x1e, 0xb4, 0x86, 0x0c, 0xed, 0x65, 0x98, 0x94, 0x90, 0x9d, 0xe6,
+	0x30, 0xfb, 0x93, 0x01, 0x53, 0xbc, 0x4d, 0xfc, 0xdf, 0xea, 0x35, 0x07, 0x63, 0x1d, 0x9f, 0x3a,
+	0xe1, 0x7d, 0xee, 0x0b, 0x36, 0x12, 0x48, 0x1f, 0x14, 0x38, 0xe4, 0x05, 0x38, 0xab, 0x42, 0x2f,
+	0x0b, 0x34, 0x02, 0x67, 0x36, 0x68, 0x5c, 0xaa, 0x24, 0xf9, 0xb3, 0x01, 0x13, 0x78, 0x22, 0x67,
+	0x5c, 0x5f, 0xed, 0x97, 0x8c, 0x27, 0x8d, 0xd1, 0x5c, 0xa7, 0x63, 0xea, 0xc5, 0xce, 0xae, 0x30,
+	0x09, 0xfe, 0x24, 0x1f, 0x1b, 0x30, 0x25, 0xe3, 0x3f, 0x65, 0x3f, 0x71, 0x5b, 0xeb, 0x27, 0x4a,
+	0x46, 0xdf, 0x27, 0xfa, 0x58, 0x41, 0x30, 0xb5, 0xfd, 0x72, 0x93, 0xdf, 0x80, 0x49, 0x89, 0xa7,
+	0xcc, 0x81, 0x5f, 0x1a, 0x30, 0xb1, 0xcd, 0xcf, 0xae, 0xaf, 0xb4, 0xe7, 0x3d, 0x07, 0x0d, 0xe6,
+	0x87, 0xa4, 0x4b, 0x14, 0x94, 0xf9, 0x1c, 0x4c, 0x76, 0x9d, 0xc7, 0x6b, 0x83, 0x90, 0x7d, 0x6b,
+	0xc3, 0xe9, 0x59, 0x9d, 0x8d, 0xea, 0xb4, 0xa7, 0xe6, 0x02, 0x98, 0x2e, 0xdd, 0x71, 0x06, 0x7e,
+	0x2c, 0xf3, 0xf2, 0x39, 0x60, 0xce, 0x0a, 0x6b, 0xf9, 0xbd, 0x68, 0xff, 0x0d, 0x7a, 0x40, 0x7d,
+	0xe1, 0xbc, 0xec, 0x81, 0xd2, 0x65, 0x8c, 0xaa, 0x5d, 0x06, 0xf9, 0xb7, 0x01, 0x13, 0xab, 0xae,
+	0x2b, 0x8c, 0xf0, 0xe4, 0xcd, 0x74, 0xa6, 0x71, 0xf5, 0x18, 0x8d, 0x6b, 0xa7, 0xd0, 0xb8, 0x7e,
+	0x32, 0x8d, 0x1b, 0x65, 0x1a, 0x8f, 0x68, 0x1a, 0xdf, 0x81, 0x29, 0x59, 0xe1, 0xd3, 0x14, 0xb6,
+	0xcf, 0x2b, 0xd0, 0xe4, 0xd5, 0xe1, 0xff, 0xc7, 0x5e, 0x38, 0xf0, 0x65, 0x45, 0xf3, 0x81, 0x1c,
+	0x42, 0xa3, 0xb6, 0xfa, 0x10, 0x4f, 0x68, 0xcd, 0x32, 0x65, 0x79, 0x77, 0x0d, 0x26, 0x36, 0x68,
+	0x5c, 0x6e, 0x44, 0xf2, 0x89, 0x01, 0x93, 0x58, 0x79, 0x24, 0xb6, 0xa7, 0x51, 0x3a, 0x15, 0x4b,
+	0xd5, 0x35, 0x4b, 0x91, 0x1f, 0x18, 0xd0, 0x54, 0x40, 0x9d, 0xfa, 0x43, 0x8e, 0x5a, 0x0f, 0x95,
+	0x4b, 0xa7, 0x52, 0x8a, 0x4e, 0x58, 0x10, 0xaf, 0xc1, 0xc4, 0x1a, 0xf5, 0x8f, 0x31, 0xe5, 0x4d,
+	0x98, 0x92, 0x99, 0x4a, 0x5c, 0xb3, 0xfc, 0xbb, 0x26, 0x40, 0xfb, 0xcd, 0xd7, 0xec, 0xd5, 0xd5,
+	0xad, 0xad, 0x37, 0xbe, 0x6d, 0xfe, 0x48, 0x38, 0x21, 0x43, 0x69, 0x5e, 0x94, 0x21, 0x0f, 0x7d,
+	0xa8, 0xb7, 0x2e, 0x97, 0x2d, 0xf7, 0xfd, 0x23, 0xf2, 0xcd, 0x8f, 0xfe, 0xfe, 0xaf, 0x4f, 0x2b,
+	0x77, 0xc8, 0xb3, 0x8b, 0x12, 0x63, 0x26, 0x72, 0x51, 0xdd, 0xb3, 0x62, 0xcc, 0xbf, 0x3b, 0x61,
+	0x8e, 0x2f, 0x1e, 0x2c, 0x2d, 0x3a, 0xfd, 0xbe, 0xef, 0xd1, 0xc8, 0xfc, 0x95, 0x01, 0x4d, 0xfd,
+	0xb3, 0x97, 0x59, 0xfa, 0x51, 0x0c, 0x71, 0x5d, 0x2d, 0x67, 0x40, 0x64, 0xaf, 0x33, 0x64, 0x77,
+	0xc9, 0xf3, 0x05, 0xc8, 0xf4, 0x5d, 0x88, 0x6d, 0xc6, 0x34, 0x25, 0x6c, 0x2b, 0x22, 0xb4, 0x7e,
+	0x63, 0x80, 0x39, 0xfc, 0xfd, 0xc3, 0x54, 0x30, 0xe4, 0x7e, 0x7e, 0xb3, 0xae, 0x1d, 0xc7, 0x82,
+	0x40, 0xdb, 0x0c, 0xe8, 0x3d, 0x72, 0xb3, 0x00, 0xe8, 0xf0, 0x3e, 0x84, 0x3a, 0x6d, 0x9e, 0x55,
+	0xa0, 0x32, 0x48, 0x9f, 0x19, 0x30, 0xa1, 0xb0, 0x9b, 0x73, 0x85, 0x08, 0x86, 0xcc, 0x98, 0xfb,
+	0x79, 0x96, 0xdc, 0x63, 0xe8, 0x5e, 0x25, 0xd7, 0x4f, 0x82, 0x0e, 0x81, 0x99, 0x66, 0x53, 0x02,
+	0xb6, 0xf8, 0x61, 0x7b, 0xed, 0x3b, 0xe6, 0x0f, 0xf9, 0xc1, 0x54, 0x84, 0x4b, 0xff, 0x50, 0x63,
+	0x5d, 0x2a, 0x59, 0x45, 0x50, 0xab, 0x0c, 0xd4, 0x2b, 0x85, 0xa0, 0x94, 0x2d, 0x08, 0xaa, 0x49,
+	0xe4, 0xa0, 0x5b, 0x31, 0xe6, 0xcd, 0x2f, 0x8c, 0xa4, 0xfc, 0x17, 0xc5, 0x5d, 0xce, 0x84, 0xdb,
+	0xba, 0x5a, 0xce, 0x80, 0xd8, 0x36, 0x19, 0xb6, 0x8d, 0xc2, 0xb8, 0xd3, 0x77, 0x21, 0xbc, 0xf3,
+	0xcb, 0x43, 0x36, 0x5b, 0x11, 0x9f, 0x4f, 0x7e, 0x69, 0xc0, 0x6c, 0xee, 0xe8, 0xd7, 0xbc, 0x5e,
+	0x34, 0xce, 0x55, 0x10, 0x3f, 0x77, 0x02, 0x2e, 0x84, 0xfd, 0x12, 0x83, 0xbd, 0x44, 0x6e, 0x15,
+	0xc0, 0xce, 0xdd, 0x8a, 0x86, 0xfc, 0xa9, 0x01, 0x33, 0x79, 0x23, 0x53, 0xf3, 0x5a, 0x59, 0xed,
+	0x10, 0xe3, 0x61, 0xeb, 0xd9, 0xe3, 0x99, 0x10, 0xdd, 0x8b, 0x0c, 0xdd, 0x6d, 0xf2, 0xc2, 0x89,
+	0xca, 0x0c, 0xdf, 0x89, 0xe0, 0x3e, 0x80, 0x33, 0xf2, 0x1c, 0xcf, 0x54, 0x46, 0xe0, 0xda, 0xe4,
+	0xd1, 0xba, 0x58, 0xbc, 0x88, 0x18, 0x16, 0x18, 0x86, 0x1b, 0xe4, 0x5a, 0x51, 0x41, 0x91, 0x76,
+	0x08, 0xd9, 0xf2, 0xf4, 0x4e, 0x95, 0xad, 0x0d, 0x08, 0xad, 0x8b, 0xc5, 0x8b, 0x27, 0x91, 0x2d,
+	0xef, 0x40, 0xd9, 0x1f, 0x19, 0x30, 0xa9, 0xce, 0xb3, 0xd4, 0x4a, 0x3f, 0x34, 0xe1, 0xb3, 0x2e,
+	0x97, 0x2d, 0x23, 0x84, 0xdb, 0x0c, 0xc2, 0x7c, 0x61, 0xa5, 0x57, 0xf7, 0x20, 0x88, 0x8f, 0x0d,
+	0x68, 0xea, 0x03, 0x10, 0xb3, 0x7c, 0xee, 0xa2, 0xa7, 0x58, 0xee, 0xfc, 0x84, 0x2c, 0x33, 0x28,
+	0xb7, 0x0a, 0x53, 0x4c, 0xdf, 0xb5, 0x62, 0xcc, 0xdf, 0x30, 0xcc, 0xef, 0x19, 0xd0, 0xd4, 0xbf,
+	0xbe, 0xa9, 0x70, 0x72, 0x3e, 0x05, 0x5a, 0x57, 0xcb, 0x19, 0x4e, 0x02, 0x47, 0xdf, 0x85, 0xb6,
+	0xf9, 0xc4, 0x60, 0x47, 0x7d, 0x51, 0x45, 0xd4, 0xc7, 0x2a, 0xd6, 0xa5, 0x92, 0xd5, 0x93, 0x94,
+	0x69, 0x65, 0x0b, 0x2b, 0xd3, 0xf3, 0x43, 0x25, 0x67, 0xf9, 0x0f, 0x35, 0x68, 0xac, 0xad, 0xbf,
+	0xd3, 0xbe, 0xb7, 0x6e, 0xbe, 0x0f, 0x63, 0xe9, 0xc0, 0xc0, 0x6c, 0x69, 0xe5, 0x38, 0xbd, 0xb1,
+	0x59, 0x56, 0xc1, 0x0a, 0x42, 0x7a, 0x9e, 0x41, 0xba, 0x4a, 0xe6, 0x64, 0x48, 0xfc, 0xf5, 0x8b,
+	0x29, 0x2b, 0xda, 0xe2, 0x00, 0xce, 0xc8, 0xd7, 0x74, 0x35, 0x51, 0xb4, 0xd9, 0x83, 0x75, 0xb1,
+	0x78, 0x11, 0x85, 0xce, 0x33, 0xa1, 0xd7, 0xc9, 0xe5, 0x1c, 0xa1, 0x32, 0x37, 0xca, 0xdd, 0x83,
+	0xb1, 0xf4, 0xc6, 0xaf, 0xea, 0x28, 0x0f, 0x02, 0xac, 0xc2, 0x5b, 0x6e, 0xa9, 0x86, 0xe9, 0x2b,
+	0x50, 0x52, 0x0f, 0x20, 0xbb, 0x76, 0x9b, 0xe7, 0xf5, 0x5c, 0xcf, 0x64, 0x5d, 0x28, 0x5a, 0x42,
+	0xdd, 0x6e, 0x30, 0x71, 0x84, 0x5c, 0xcc, 0x11, 0x97, 0xf1, 0xa2, 0xbc, 0xf7, 0x61, 0x2c, 0xbd,
+	0x34, 0x9b, 0x1a, 0x7e, 0xbf, 0xc0, 0x7b, 0xea, 0x2d, 0xbb, 0x54, 0xb7, 0x94, 0x75, 0xc5, 0x98,
+	0x5f, 0xfe, 0x6b, 0x0d, 0x46, 0xb6, 0xdf, 0xbe, 0xfb, 0xad, 0xf5, 0x7b, 0xf7, 0xcd, 0x00, 0x20,
+	0xbb, 0x8e, 0xa9, 0x7a, 0x2a, 0xf7, 0x52, 0xeb, 0x42, 0xd1, 0x12, 0x8a, 0xbe, 0xc9, 0x44, 0x5f,
+	0x23, 0x97, 0x64, 0xd1, 0x42, 0xc4, 0x62, 0xc6, 0x8c, 0x8a, 0x7e, 0x08, 0x13, 0xca, 0x4d, 0x45,
+	0xcd, 0x22, 0xfd, 0x7a, 0x67, 0x5d, 0x2a, 0x59, 0x45, 0xc9, 0xb7, 0x98, 0xe4, 0xe7, 0xc8, 0xd5,
+	0x3c, 0xc9, 0x0a, 0x3f, 0x0a, 0xef, 0x02, 0x64, 0x17, 0x1f, 0x55, 0x5b, 0xe5, 0x42, 0x64, 0x15,
+	0xdf, 0x0b, 0xca, 0x75, 0xcd, 0xde, 0x82, 0xe2, 0x22, 0x18, 0x97, 0x2e, 0x2b, 0xa6, 0xa5, 0x87,
+	0x8a, 0x24, 0x70, 0xae, 0x70, 0xad, 0x30, 0x47, 0x12, 0x99, 0x12, 0x37, 0x0a, 0x0d, 0x00, 0xb2,
+	0xcb, 0x86, 0xaa, 0xa3, 0x72, 0x53, 0xb1, 0x2e, 0x14, 0x2d, 0x1d, 0xeb, 0xd1, 0x8c, 0x79, 0xc5,
+	0x98, 0xbf, 0x5b, 0x7b, 0xb7, 0x72, 0xb0, 0xf4, 0xb0, 0xc1, 0xfe, 0x18, 0xf2, 0xb5, 0xff, 0x0e,
+	0x00, 0xfa, 0x26, 0x0d, 0xe2, 0xf9, 0x2c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// INFRAAPPLYClient is the client API for INFRAAPPLY service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type INFRAAPPLYClient interface {
+	// List infra apply
+	ListInfraApply(ctx context.Context, in *ListInfraApplyReq, opts ...grpc.CallOption) (*ListInfraApplyReply, error)
+	// Full-text search of infra applies, the best matches first
+	SearchInfraApply(ctx context.Context, in *SearchInfraApplyReq, opts ...grpc.CallOption) (*SearchInfraApplyReply, error)
+	// Aggregate infra applies for dashboards, from a summary refreshed in the background
+	GetInfraApplyStats(ctx context.Context, in *GetInfraApplyStatsReq, opts ...grpc.CallOption) (*GetInfraApplyStatsReply, error)
+	// Get infra apply by ID
+	GetInfraApply(ctx context.Context, in *GetInfraApplyReq, opts ...grpc.CallOption) (*DetailInfraApplyReply, error)
+	// Add infra apply
+	AddInfraApply(ctx context.Context, in *AddInfraApplyReq, opts ...grpc.CallOption) (*AddInfraApplyReply, error)
+	// Update infra apply
+	UpdateInfraApply(ctx context.Context, in *UpdateInfraApplyReq, opts ...grpc.CallOption) (*UpdateInfraApplyReply, error)
+	// Approve or refuse many infra applies at once
+	BatchUpdateInfraApply(ctx context.Context, in *BatchUpdateInfraApplyReq, opts ...grpc.CallOption) (*BatchUpdateInfraApplyReply, error)
+	// List the reviews of an infra apply
+	ListInfraApplyReview(ctx context.Context, in *ListInfraApplyReviewReq, opts ...grpc.CallOption) (*ListInfraApplyReviewReply, error)
+	// Save a workflow and attach it to subjects
+	SaveWorkflow(ctx context.Context, in *SaveWorkflowReq, opts ...grpc.CallOption) (*SaveWorkflowReply, error)
+	// List workflows
+	ListWorkflow(ctx context.Context, in *ListWorkflowReq, opts ...grpc.CallOption) (*ListWorkflowReply, error)
+	// Evaluate the policy rules for an apply without saving it
+	SimulatePolicy(ctx context.Context, in *SimulatePolicyReq, opts ...grpc.CallOption) (*SimulatePolicyReply, error)
+	// Import existing applies and grants, rows are streamed by the client
+	ImportInfraApply(ctx context.Context, opts ...grpc.CallOption) (INFRAAPPLY_ImportInfraApplyClient, error)
+	// Ask for a new expiry of a granted apply, the extension is reviewed like a new apply
+	ExtendInfraApply(ctx context.Context, in *ExtendInfraApplyReq, opts ...grpc.CallOption) (*ExtendInfraApplyReply, error)
+	//Delete infra apply
+	DelInfraApply(ctx context.Context, in *DelInfraApplyReq, opts ...grpc.CallOption) (*DelInfraApplyReply, error)
+}
+
+type iNFRAAPPLYClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewINFRAAPPLYClient(cc grpc.ClientConnInterface) INFRAAPPLYClient {
+	return &iNFRAAPPLYClient{cc}
+}
+
+func (c *iNFRAAPPLYClient) ListInfraApply(ctx context.Context, in *ListInfraApplyReq, opts ...grpc.CallOption) (*ListInfraApplyReply, error) {
+	out := new(ListInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/ListInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) SearchInfraApply(ctx context.Context, in *SearchInfraApplyReq, opts ...grpc.CallOption) (*SearchInfraApplyReply, error) {
+	out := new(SearchInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/SearchInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) GetInfraApplyStats(ctx context.Context, in *GetInfraApplyStatsReq, opts ...grpc.CallOption) (*GetInfraApplyStatsReply, error) {
+	out := new(GetInfraApplyStatsReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/GetInfraApplyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) GetInfraApply(ctx context.Context, in *GetInfraApplyReq, opts ...grpc.CallOption) (*DetailInfraApplyReply, error) {
+	out := new(DetailInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/GetInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) AddInfraApply(ctx context.Context, in *AddInfraApplyReq, opts ...grpc.CallOption) (*AddInfraApplyReply, error) {
+	out := new(AddInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/AddInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) UpdateInfraApply(ctx context.Context, in *UpdateInfraApplyReq, opts ...grpc.CallOption) (*UpdateInfraApplyReply, error) {
+	out := new(UpdateInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/UpdateInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) BatchUpdateInfraApply(ctx context.Context, in *BatchUpdateInfraApplyReq, opts ...grpc.CallOption) (*BatchUpdateInfraApplyReply, error) {
+	out := new(BatchUpdateInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/BatchUpdateInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) ListInfraApplyReview(ctx context.Context, in *ListInfraApplyReviewReq, opts ...grpc.CallOption) (*ListInfraApplyReviewReply, error) {
+	out := new(ListInfraApplyReviewReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/ListInfraApplyReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) SaveWorkflow(ctx context.Context, in *SaveWorkflowReq, opts ...grpc.CallOption) (*SaveWorkflowReply, error) {
+	out := new(SaveWorkflowReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/SaveWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) ListWorkflow(ctx context.Context, in *ListWorkflowReq, opts ...grpc.CallOption) (*ListWorkflowReply, error) {
+	out := new(ListWorkflowReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/ListWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) SimulatePolicy(ctx context.Context, in *SimulatePolicyReq, opts ...grpc.CallOption) (*SimulatePolicyReply, error) {
+	out := new(SimulatePolicyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/SimulatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) ImportInfraApply(ctx context.Context, opts ...grpc.CallOption) (INFRAAPPLY_ImportInfraApplyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_INFRAAPPLY_serviceDesc.Streams[0], "/InfraApply.INFRAAPPLY/ImportInfraApply", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &iNFRAAPPLYImportInfraApplyClient{stream}
+	return x, nil
+}
+
+type INFRAAPPLY_ImportInfraApplyClient interface {
+	Send(*ImportInfraApplyReq) error
+	CloseAndRecv() (*ImportInfraApplyReply, error)
+	grpc.ClientStream
+}
+
+type iNFRAAPPLYImportInfraApplyClient struct {
+	grpc.ClientStream
+}
+
+func (x *iNFRAAPPLYImportInfraApplyClient) Send(m *ImportInfraApplyReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *iNFRAAPPLYImportInfraApplyClient) CloseAndRecv() (*ImportInfraApplyReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportInfraApplyReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *iNFRAAPPLYClient) ExtendInfraApply(ctx context.Context, in *ExtendInfraApplyReq, opts ...grpc.CallOption) (*ExtendInfraApplyReply, error) {
+	out := new(ExtendInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/ExtendInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) DelInfraApply(ctx context.Context, in *DelInfraApplyReq, opts ...grpc.CallOption) (*DelInfraApplyReply, error) {
+	out := new(DelInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/DelInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// INFRAAPPLYServer is the server API for INFRAAPPLY service.
+type INFRAAPPLYServer interface {
+	// List infra apply
+	ListInfraApply(context.Context, *ListInfraApplyReq) (*ListInfraApplyReply, error)
+	// Full-text search of infra applies, the best matches first
+	SearchInfraApply(context.Context, *SearchInfraApplyReq) (*SearchInfraApplyReply, error)
+	// Aggregate infra applies for dashboards, from a summary refreshed in the background
+	GetInfraApplyStats(context.Context, *GetInfraApplyStatsReq) (*GetInfraApplyStatsReply, error)
+	// Get infra apply by ID
+	GetInfraApply(context.Context, *GetInfraApplyReq) (*DetailInfraApplyReply, error)
+	// Add infra apply
+	AddInfraApply(context.Context, *AddInfraApplyReq) (*AddInfraApplyReply, error)
+	// Update infra apply
+	UpdateInfraApply(context.Context, *UpdateInfraApplyReq) (*UpdateInfraApplyReply, error)
+	// Approve or refuse many infra applies at once
+	BatchUpdateInfraApply(context.Context, *BatchUpdateInfraApplyReq) (*BatchUpdateInfraApplyReply, error)
+	// List the reviews of an infra apply
+	ListInfraApplyReview(context.Context, *ListInfraApplyReviewReq) (*ListInfraApplyReviewReply, error)
+	// Save a workflow and attach it to subjects
+	SaveWorkflow(context.Context, *SaveWorkflowReq) (*SaveWorkflowReply, error)
+	// List workflows
+	ListWorkflow(context.Context, *ListWorkflowReq) (*ListWorkflowReply, error)
+	// Evaluate the policy rules for an apply without saving it
+	SimulatePolicy(context.Context, *SimulatePolicyReq) (*SimulatePolicyReply, error)
+	// Import existing applies and grants, rows are streamed by the client
+	ImportInfraApply(INFRAAPPLY_ImportInfraApplyServer) error
+	// Ask for a new expiry of a granted apply, the extension is reviewed like a new apply
+	ExtendInfraApply(context.Context, *ExtendInfraApplyReq) (*ExtendInfraApplyReply, error)
+	//Delete infra apply
+	DelInfraApply(context.Context, *DelInfraApplyReq) (*DelInfraApplyReply, error)
+}
+
+// UnimplementedINFRAAPPLYServer can be embedded to have forward compatible implementations.
+type UnimplementedINFRAAPPLYServer struct {
+}
+
+func (*UnimplementedINFRAAPPLYServer) ListInfraApply(ctx context.Context, req *ListInfraApplyReq) (*ListInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) SearchInfraApply(ctx context.Context, req *SearchInfraApplyReq) (*SearchInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) GetInfraApplyStats(ctx context.Context, req *GetInfraApplyStatsReq) (*GetInfraApplyStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfraApplyStats not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) GetInfraApply(ctx context.Context, req *GetInfraApplyReq) (*DetailInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) AddInfraApply(ctx context.Context, req *AddInfraApplyReq) (*AddInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) UpdateInfraApply(ctx context.Context, req *UpdateInfraApplyReq) (*UpdateInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) BatchUpdateInfraApply(ctx context.Context, req *BatchUpdateInfraApplyReq) (*BatchUpdateInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) ListInfraApplyReview(ctx context.Context, req *ListInfraApplyReviewReq) (*ListInfraApplyReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInfraApplyReview not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) SaveWorkflow(ctx context.Context, req *SaveWorkflowReq) (*SaveWorkflowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWorkflow not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) ListWorkflow(ctx context.Context, req *ListWorkflowReq) (*ListWorkflowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflow not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) SimulatePolicy(ctx context.Context, req *SimulatePolicyReq) (*SimulatePolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePolicy not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) ImportInfraApply(srv INFRAAPPLY_ImportInfraApplyServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) ExtendInfraApply(ctx context.Context, req *ExtendInfraApplyReq) (*ExtendInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) DelInfraApply(ctx context.Context, req *DelInfraApplyReq) (*DelInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelInfraApply not implemented")
+}
+
+func RegisterINFRAAPPLYServer(s *grpc.Server, srv INFRAAPPLYServer) {
+	s.RegisterService(&_INFRAAPPLY_serviceDesc, srv)
+}
+
+func _INFRAAPPLY_ListInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).ListInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/ListInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).ListInfraApply(ctx, req.(*ListInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_SearchInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).SearchInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/SearchInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).SearchInfraApply(ctx, req.(*SearchInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_GetInfraApplyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfraApplyStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).GetInfraApplyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/GetInfraApplyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).GetInfraApplyStats(ctx, req.(*GetInfraApplyStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_GetInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).GetInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/GetInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).GetInfraApply(ctx, req.(*GetInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_AddInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).AddInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/AddInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).AddInfraApply(ctx, req.(*AddInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_UpdateInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).UpdateInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/UpdateInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).UpdateInfraApply(ctx, req.(*UpdateInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_BatchUpdateInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).BatchUpdateInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/BatchUpdateInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).BatchUpdateInfraApply(ctx, req.(*BatchUpdateInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_ListInfraApplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInfraApplyReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).ListInfraApplyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/ListInfraApplyReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).ListInfraApplyReview(ctx, req.(*ListInfraApplyReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_SaveWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveWorkflowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).SaveWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/SaveWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).SaveWorkflow(ctx, req.(*SaveWorkflowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_ListWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).ListWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/ListWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).ListWorkflow(ctx, req.(*ListWorkflowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_SimulatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).SimulatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/SimulatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).SimulatePolicy(ctx, req.(*SimulatePolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_ImportInfraApply_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(INFRAAPPLYServer).ImportInfraApply(&iNFRAAPPLYImportInfraApplyServer{stream})
+}
+
+type INFRAAPPLY_ImportInfraApplyServer interface {
+	SendAndClose(*ImportInfraApplyReply) error
+	Recv() (*ImportInfraApplyReq, error)
+	grpc.ServerStream
+}
+
+type iNFRAAPPLYImportInfraApplyServer struct {
+	grpc.ServerStream
+}
+
+func (x *iNFRAAPPLYImportInfraApplyServer) SendAndClose(m *ImportInfraApplyReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *iNFRAAPPLYImportInfraApplyServer) Recv() (*ImportInfraApplyReq, error) {
+	m := new(ImportInfraApplyReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _INFRAAPPLY_ExtendInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).ExtendInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/ExtendInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).ExtendInfraApply(ctx, req.(*ExtendInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_DelInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).DelInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.INFRAAPPLY/DelInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).DelInfraApply(ctx, req.(*DelInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _INFRAAPPLY_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "InfraApply.INFRAAPPLY",
+	HandlerType: (*INFRAAPPLYServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInfraApply",
+			Handler:    _INFRAAPPLY_ListInfraApply_Handler,
+		},
+		{
+			MethodName: "SearchInfraApply",
+			Handler:    _INFRAAPPLY_SearchInfraApply_Handler,
+		},
+		{
+			MethodName: "GetInfraApplyStats",
+			Handler:    _INFRAAPPLY_GetInfraApplyStats_Handler,
+		},
+		{
+			MethodName: "GetInfraApply",
+			Handler:    _INFRAAPPLY_GetInfraApply_Handler,
+		},
+		{
+			MethodName: "AddInfraApply",
+			Handler:    _INFRAAPPLY_AddInfraApply_Handler,
+		},
+		{
+			MethodName: "UpdateInfraApply",
+			Handler:    _INFRAAPPLY_UpdateInfraApply_Handler,
+		},
+		{
+			MethodName: "BatchUpdateInfraApply",
+			Handler:    _INFRAAPPLY_BatchUpdateInfraApply_Handler,
+		},
+		{
+			MethodName: "ListInfraApplyReview",
+			Handler:    _INFRAAPPLY_ListInfraApplyReview_Handler,
+		},
+		{
+			MethodName: "SaveWorkflow",
+			Handler:    _INFRAAPPLY_SaveWorkflow_Handler,
+		},
+		{
+			MethodName: "ListWorkflow",
+			Handler:    _INFRAAPPLY_ListWorkflow_Handler,
+		},
+		{
+			MethodName: "SimulatePolicy",
+			Handler:    _INFRAAPPLY_SimulatePolicy_Handler,
+		},
+		{
+			MethodName: "ExtendInfraApply",
+			Handler:    _INFRAAPPLY_ExtendInfraApply_Handler,
+		},
+		{
+			MethodName: "DelInfraApply",
+			Handler:    _INFRAAPPLY_DelInfraApply_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportInfraApply",
+			Handler:       _INFRAAPPLY_ImportInfraApply_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "microservcice.proto",
+}
+
+// DEVICEClient is the client API for DEVICE service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DEVICEClient interface {
+	// Add device
+	AddDevice(ctx context.Context, in *AddDeviceReq, opts ...grpc.CallOption) (*AddDeviceReply, error)
+	// Update device
+	UpdateDevice(ctx context.Context, in *UpdateDeviceReq, opts ...grpc.CallOption) (*UpdateDeviceReply, error)
+	// Get device by code
+	GetDevice(ctx context.Context, in *GetDeviceReq, opts ...grpc.CallOption) (*DeviceDetail, error)
+	// List and search devices
+	ListDevice(ctx context.Context, in *ListDeviceReq, opts ...grpc.CallOption) (*ListDeviceReply, error)
+	// Delete device
+	DelDevice(ctx context.Context, in *DelDeviceReq, opts ...grpc.CallOption) (*DelDeviceReply, error)
 }
-func (m *DelInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DelInfraApplyReply.Marshal(b, m, deterministic)
+
+type dEVICEClient struct {
+	cc grpc.ClientConnInterface
 }
-func (m *DelInfraApplyReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelInfraApplyReply.Merge(m, src)
+
+func NewDEVICEClient(cc grpc.ClientConnInterface) DEVICEClient {
+	return &dEVICEClient{cc}
 }
-func (m *DelInfraApplyReply) XXX_Size() int {
-	return xxx_messageInfo_DelInfraApplyReply.Size(m)
+
+func (c *dEVICEClient) AddDevice(ctx context.Context, in *AddDeviceReq, opts ...grpc.CallOption) (*AddDeviceReply, error) {
+	out := new(AddDeviceReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.DEVICE/AddDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *DelInfraApplyReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelInfraApplyReply.DiscardUnknown(m)
+
+func (c *dEVICEClient) UpdateDevice(ctx context.Context, in *UpdateDeviceReq, opts ...grpc.CallOption) (*UpdateDeviceReply, error) {
+	out := new(UpdateDeviceReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.DEVICE/UpdateDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-var xxx_messageInfo_DelInfraApplyReply proto.InternalMessageInfo
+func (c *dEVICEClient) GetDevice(ctx context.Context, in *GetDeviceReq, opts ...grpc.CallOption) (*DeviceDetail, error) {
+	out := new(DeviceDetail)
+	err := c.cc.Invoke(ctx, "/InfraApply.DEVICE/GetDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (m *DelInfraApplyReply) GetResult() string {
-	if m != nil {
-		return m.Result
+func (c *dEVICEClient) ListDevice(ctx context.Context, in *ListDeviceReq, opts ...grpc.CallOption) (*ListDeviceReply, error) {
+	out := new(ListDeviceReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.DEVICE/ListDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return ""
+	return out, nil
 }
 
-func init() {
-	proto.RegisterType((*ModelPage)(nil), "InfraApply.ModelPage")
-	proto.RegisterType((*ListInfraApplyReq)(nil), "InfraApply.ListInfraApplyReq")
-	proto.RegisterType((*ListInfraApplyReply)(nil), "InfraApply.ListInfraApplyReply")
-	proto.RegisterType((*DetailInfraApplyReply)(nil), "InfraApply.DetailInfraApplyReply")
-	proto.RegisterType((*AddInfraApplyReq)(nil), "InfraApply.AddInfraApplyReq")
-	proto.RegisterType((*AddInfraApplyReply)(nil), "InfraApply.AddInfraApplyReply")
-	proto.RegisterType((*UpdateInfraApplyReq)(nil), "InfraApply.UpdateInfraApplyReq")
-	proto.RegisterType((*UpdateInfraApplyReply)(nil), "InfraApply.UpdateInfraApplyReply")
-	proto.RegisterType((*DelInfraApplyReq)(nil), "InfraApply.DelInfraApplyReq")
-	proto.RegisterType((*DelInfraApplyReply)(nil), "InfraApply.DelInfraApplyReply")
+func (c *dEVICEClient) DelDevice(ctx context.Context, in *DelDeviceReq, opts ...grpc.CallOption) (*DelDeviceReply, error) {
+	out := new(DelDeviceReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.DEVICE/DelDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func init() { proto.RegisterFile("microservcice.proto", fileDescriptor_1659b64737a352e6) }
+// DEVICEServer is the server API for DEVICE service.
+type DEVICEServer interface {
+	// Add device
+	AddDevice(context.Context, *AddDeviceReq) (*AddDeviceReply, error)
+	// Update device
+	UpdateDevice(context.Context, *UpdateDeviceReq) (*UpdateDeviceReply, error)
+	// Get device by code
+	GetDevice(context.Context, *GetDeviceReq) (*DeviceDetail, error)
+	// List and search devices
+	ListDevice(context.Context, *ListDeviceReq) (*ListDeviceReply, error)
+	// Delete device
+	DelDevice(context.Context, *DelDeviceReq) (*DelDeviceReply, error)
+}
 
-var fileDescriptor_1659b64737a352e6 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x65, 0x27, 0x4d, 0x9b, 0xa9, 0xa8, 0xc2, 0x86, 0x54, 0x56, 0x14, 0x4a, 0x6a, 0x81,
-	0x68, 0xa3, 0x2a, 0x86, 0xc0, 0x85, 0xde, 0x02, 0x11, 0x92, 0xa5, 0xa6, 0x8a, 0x4c, 0x10, 0x2a,
-	0xb7, 0xad, 0x3d, 0xa4, 0x46, 0x6e, 0x6c, 0xec, 0x4d, 0x48, 0x38, 0x70, 0xc8, 0x91, 0x2b, 0x27,
-	0x2e, 0xbc, 0x14, 0xaf, 0xc0, 0x9d, 0x57, 0x40, 0xbb, 0x71, 0x62, 0xaf, 0xf3, 0x07, 0x89, 0x9b,
-	0x67, 0x67, 0x3f, 0xcf, 0x6f, 0xbe, 0x19, 0xcb, 0x50, 0xbe, 0x75, 0xed, 0xd0, 0x8f, 0x30, 0x1c,
-	0xdb, 0xae, 0x8d, 0xcd, 0x20, 0xf4, 0x99, 0x4f, 0xc0, 0x1c, 0x7e, 0x08, 0x69, 0x3b, 0x08, 0xbc,
-	0x69, 0xb5, 0x36, 0xf0, 0xfd, 0x81, 0x87, 0x06, 0x0d, 0x5c, 0x83, 0x0e, 0x87, 0x3e, 0xa3, 0xcc,
-	0xf5, 0x87, 0xd1, 0xfc, 0xa6, 0xfe, 0x0e, 0x8a, 0x5d, 0xdf, 0x41, 0xaf, 0x47, 0x07, 0x48, 0x34,
-	0xd8, 0x0d, 0xe8, 0x00, 0x4d, 0x67, 0xa2, 0x29, 0x75, 0xe5, 0x64, 0xc7, 0x5a, 0x84, 0xa4, 0x0a,
-	0x7b, 0xfc, 0xf1, 0x8d, 0xfb, 0x05, 0x35, 0x55, 0xa4, 0x96, 0x31, 0xb9, 0x07, 0x3b, 0xcc, 0x67,
-	0xd4, 0xd3, 0x72, 0x22, 0x31, 0x0f, 0x74, 0x0a, 0x77, 0x2f, 0xdc, 0x88, 0x25, 0x20, 0x16, 0x7e,
-	0xfa, 0xcf, 0x02, 0x87, 0x50, 0x88, 0x90, 0x86, 0xf6, 0x8d, 0xa8, 0x50, 0xb4, 0xe2, 0x48, 0xff,
-	0xa1, 0x40, 0x39, 0x5b, 0x23, 0xf0, 0xa6, 0xe4, 0x14, 0xf2, 0x5c, 0x2b, 0x4a, 0xec, 0xb7, 0x2a,
-	0xcd, 0x24, 0xdf, 0x5c, 0xf6, 0x6a, 0x89, 0x2b, 0xe4, 0x05, 0x14, 0x42, 0xb4, 0xfd, 0xd0, 0xd1,
-	0xd4, 0x7a, 0xee, 0x64, 0xbf, 0x75, 0x9c, 0xbe, 0xdc, 0x41, 0x46, 0x5d, 0x2f, 0xf3, 0x76, 0x2b,
-	0x16, 0x90, 0x1a, 0x14, 0x71, 0x72, 0x43, 0x47, 0x11, 0x43, 0x47, 0x80, 0xed, 0x59, 0xc9, 0x81,
-	0xfe, 0x47, 0x81, 0xca, 0x5a, 0x3d, 0x39, 0x00, 0xd5, 0xec, 0xc4, 0xed, 0xab, 0x66, 0x87, 0x1c,
-	0x01, 0x38, 0x38, 0x76, 0x6d, 0x7c, 0xe5, 0x3b, 0xf3, 0xde, 0x8b, 0x56, 0xea, 0x84, 0x7b, 0x46,
-	0xb9, 0x1a, 0xc3, 0xb8, 0xfd, 0x45, 0x28, 0x7c, 0x61, 0x94, 0x8d, 0x22, 0x2d, 0x1f, 0xfb, 0x22,
-	0x22, 0x52, 0x87, 0xfd, 0x68, 0x74, 0xfd, 0x11, 0x6d, 0x76, 0x49, 0x6f, 0x51, 0xdb, 0x11, 0xc9,
-	0xf4, 0x11, 0x77, 0x3b, 0xc4, 0xb1, 0x8b, 0x9f, 0x4d, 0x47, 0x2b, 0x88, 0xf4, 0x32, 0xe6, 0x39,
-	0x9c, 0x04, 0x6e, 0x88, 0xfd, 0xae, 0xb6, 0x3b, 0xcf, 0x2d, 0xe2, 0x44, 0xd7, 0xef, 0x6a, 0x7b,
-	0x69, 0x5d, 0xbf, 0xab, 0xcf, 0x14, 0x28, 0xb5, 0x1d, 0x47, 0x1e, 0xb8, 0xdc, 0x9c, 0xb2, 0xd2,
-	0x5c, 0x09, 0x72, 0x23, 0xd7, 0x89, 0xbb, 0xe6, 0x8f, 0x59, 0xf8, 0xdc, 0x5a, 0xf8, 0x25, 0x60,
-	0x5e, 0x06, 0xd4, 0xcf, 0x80, 0x64, 0x18, 0xb8, 0xe5, 0x87, 0x7c, 0xca, 0xd1, 0xc8, 0x63, 0x31,
-	0x41, 0x1c, 0xe9, 0x57, 0x50, 0x7e, 0x1b, 0x38, 0x94, 0xa1, 0x0c, 0x9d, 0x9d, 0x50, 0xe2, 0xb3,
-	0x2a, 0xf9, 0x9c, 0x06, 0xc9, 0x65, 0x40, 0x0c, 0xa8, 0xac, 0xbe, 0x7a, 0x1b, 0xcb, 0x73, 0x28,
-	0x75, 0xd0, 0xdb, 0x04, 0x52, 0x14, 0x20, 0x2b, 0x6e, 0xf1, 0x7e, 0x33, 0xaa, 0x2d, 0x35, 0x5a,
-	0x3f, 0xf3, 0x00, 0xe6, 0xe5, 0x6b, 0xab, 0xdd, 0xee, 0xf5, 0x2e, 0xae, 0xc8, 0x4c, 0x81, 0x03,
-	0xf9, 0xfb, 0x21, 0xf7, 0xd3, 0xfb, 0xbf, 0xf2, 0xfd, 0x56, 0x1f, 0x6c, 0x4b, 0x07, 0xde, 0x54,
-	0x7f, 0x32, 0xfb, 0xf5, 0xfb, 0xbb, 0xda, 0xd0, 0x1f, 0x19, 0xa9, 0x8b, 0x49, 0x49, 0x43, 0xd6,
-	0x9c, 0x2b, 0x0d, 0xf2, 0x15, 0xee, 0x48, 0x13, 0x23, 0xb5, 0x74, 0x8d, 0xec, 0x42, 0x55, 0x8f,
-	0xb6, 0x64, 0x39, 0x80, 0x21, 0x00, 0x4e, 0xf5, 0x87, 0x1b, 0x00, 0x24, 0x09, 0xaf, 0xff, 0x4d,
-	0x81, 0x52, 0x76, 0x52, 0x44, 0xea, 0x73, 0xcd, 0x8a, 0x54, 0x8f, 0xb7, 0x5f, 0xe0, 0x24, 0x2d,
-	0x41, 0x72, 0xa6, 0x3f, 0xde, 0x40, 0x92, 0x55, 0xc5, 0x66, 0x48, 0xe3, 0x94, 0xcd, 0xc8, 0xee,
-	0x87, 0x6c, 0xc6, 0xea, 0x1e, 0xfc, 0xd3, 0x0c, 0x49, 0x72, 0xae, 0x34, 0x5e, 0xe6, 0xdf, 0xab,
-	0xe3, 0xa7, 0xd7, 0x05, 0xf1, 0x6b, 0x78, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x40, 0xca,
-	0x89, 0x5b, 0x06, 0x00, 0x00,
+// UnimplementedDEVICEServer can be embedded to have forward compatible implementations.
+type UnimplementedDEVICEServer struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (*UnimplementedDEVICEServer) AddDevice(ctx context.Context, req *AddDeviceReq) (*AddDeviceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDevice not implemented")
+}
+func (*UnimplementedDEVICEServer) UpdateDevice(ctx context.Context, req *UpdateDeviceReq) (*UpdateDeviceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDevice not implemented")
+}
+func (*UnimplementedDEVICEServer) GetDevice(ctx context.Context, req *GetDeviceReq) (*DeviceDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (*UnimplementedDEVICEServer) ListDevice(ctx context.Context, req *ListDeviceReq) (*ListDeviceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevice not implemented")
+}
+func (*UnimplementedDEVICEServer) DelDevice(ctx context.Context, req *DelDeviceReq) (*DelDeviceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelDevice not implemented")
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func RegisterDEVICEServer(s *grpc.Server, srv DEVICEServer) {
+	s.RegisterService(&_DEVICE_serviceDesc, srv)
+}
 
-// INFRAAPPLYClient is the client API for INFRAAPPLY service.
+func _DEVICE_AddDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DEVICEServer).AddDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.DEVICE/AddDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DEVICEServer).AddDevice(ctx, req.(*AddDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DEVICE_UpdateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DEVICEServer).UpdateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.DEVICE/UpdateDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DEVICEServer).UpdateDevice(ctx, req.(*UpdateDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DEVICE_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DEVICEServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.DEVICE/GetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DEVICEServer).GetDevice(ctx, req.(*GetDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DEVICE_ListDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DEVICEServer).ListDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.DEVICE/ListDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DEVICEServer).ListDevice(ctx, req.(*ListDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DEVICE_DelDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelDeviceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DEVICEServer).DelDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.DEVICE/DelDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DEVICEServer).DelDevice(ctx, req.(*DelDeviceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DEVICE_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "InfraApply.DEVICE",
+	HandlerType: (*DEVICEServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddDevice",
+			Handler:    _DEVICE_AddDevice_Handler,
+		},
+		{
+			MethodName: "UpdateDevice",
+			Handler:    _DEVICE_UpdateDevice_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _DEVICE_GetDevice_Handler,
+		},
+		{
+			MethodName: "ListDevice",
+			Handler:    _DEVICE_ListDevice_Handler,
+		},
+		{
+			MethodName: "DelDevice",
+			Handler:    _DEVICE_DelDevice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "microservcice.proto",
+}
+
+// SUBJECTClient is the client API for SUBJECT service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type INFRAAPPLYClient interface {
-	// List infra apply
-	ListInfraApply(ctx context.Context, in *ListInfraApplyReq, opts ...grpc.CallOption) (*ListInfraApplyReply, error)
-	// Add infra apply
-	AddInfraApply(ctx context.Context, in *AddInfraApplyReq, opts ...grpc.CallOption) (*AddInfraApplyReply, error)
-	// Update infra apply
-	UpdateInfraApply(ctx context.Context, in *UpdateInfraApplyReq, opts ...grpc.CallOption) (*UpdateInfraApplyReply, error)
-	//Delete infra
-	DelInfraApply(ctx context.Context, in *DelInfraApplyReq, opts ...grpc.CallOption) (*DelInfraApplyReply, error)
+type SUBJECTClient interface {
+	// Add subject to the catalog
+	AddSubject(ctx context.Context, in *AddSubjectReq, opts ...grpc.CallOption) (*AddSubjectReply, error)
+	// Update subject
+	UpdateSubject(ctx context.Context, in *UpdateSubjectReq, opts ...grpc.CallOption) (*UpdateSubjectReply, error)
+	// Get subject by name
+	GetSubject(ctx context.Context, in *GetSubjectReq, opts ...grpc.CallOption) (*SubjectDetail, error)
+	// List and search subjects
+	ListSubject(ctx context.Context, in *ListSubjectReq, opts ...grpc.CallOption) (*ListSubjectReply, error)
+	// Delete subject
+	DelSubject(ctx context.Context, in *DelSubjectReq, opts ...grpc.CallOption) (*DelSubjectReply, error)
 }
 
-type iNFRAAPPLYClient struct {
+type sUBJECTClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewINFRAAPPLYClient(cc grpc.ClientConnInterface) INFRAAPPLYClient {
-	return &iNFRAAPPLYClient{cc}
+func NewSUBJECTClient(cc grpc.ClientConnInterface) SUBJECTClient {
+	return &sUBJECTClient{cc}
 }
 
-func (c *iNFRAAPPLYClient) ListInfraApply(ctx context.Context, in *ListInfraApplyReq, opts ...grpc.CallOption) (*ListInfraApplyReply, error) {
-	out := new(ListInfraApplyReply)
-	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/ListInfraApply", in, out, opts...)
+func (c *sUBJECTClient) AddSubject(ctx context.Context, in *AddSubjectReq, opts ...grpc.CallOption) (*AddSubjectReply, error) {
+	out := new(AddSubjectReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.SUBJECT/AddSubject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iNFRAAPPLYClient) AddInfraApply(ctx context.Context, in *AddInfraApplyReq, opts ...grpc.CallOption) (*AddInfraApplyReply, error) {
-	out := new(AddInfraApplyReply)
-	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/AddInfraApply", in, out, opts...)
+func (c *sUBJECTClient) UpdateSubject(ctx context.Context, in *UpdateSubjectReq, opts ...grpc.CallOption) (*UpdateSubjectReply, error) {
+	out := new(UpdateSubjectReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.SUBJECT/UpdateSubject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iNFRAAPPLYClient) UpdateInfraApply(ctx context.Context, in *UpdateInfraApplyReq, opts ...grpc.CallOption) (*UpdateInfraApplyReply, error) {
-	out := new(UpdateInfraApplyReply)
-	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/UpdateInfraApply", in, out, opts...)
+func (c *sUBJECTClient) GetSubject(ctx context.Context, in *GetSubjectReq, opts ...grpc.CallOption) (*SubjectDetail, error) {
+	out := new(SubjectDetail)
+	err := c.cc.Invoke(ctx, "/InfraApply.SUBJECT/GetSubject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *iNFRAAPPLYClient) DelInfraApply(ctx context.Context, in *DelInfraApplyReq, opts ...grpc.CallOption) (*DelInfraApplyReply, error) {
-	out := new(DelInfraApplyReply)
-	err := c.cc.Invoke(ctx, "/InfraApply.INFRAAPPLY/DelInfraApply", in, out, opts...)
+func (c *sUBJECTClient) ListSubject(ctx context.Context, in *ListSubjectReq, opts ...grpc.CallOption) (*ListSubjectReply, error) {
+	out := new(ListSubjectReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.SUBJECT/ListSubject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// INFRAAPPLYServer is the server API for INFRAAPPLY service.
-type INFRAAPPLYServer interface {
-	// List infra apply
-	ListInfraApply(context.Context, *ListInfraApplyReq) (*ListInfraApplyReply, error)
-	// Add infra apply
-	AddInfraApply(context.Context, *AddInfraApplyReq) (*AddInfraApplyReply, error)
-	// Update infra apply
-	UpdateInfraApply(context.Context, *UpdateInfraApplyReq) (*UpdateInfraApplyReply, error)
-	//Delete infra
-	DelInfraApply(context.Context, *DelInfraApplyReq) (*DelInfraApplyReply, error)
+func (c *sUBJECTClient) DelSubject(ctx context.Context, in *DelSubjectReq, opts ...grpc.CallOption) (*DelSubjectReply, error) {
+	out := new(DelSubjectReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.SUBJECT/DelSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedINFRAAPPLYServer can be embedded to have forward compatible implementations.
-type UnimplementedINFRAAPPLYServer struct {
+// SUBJECTServer is the server API for SUBJECT service.
+type SUBJECTServer interface {
+	// Add subject to the catalog
+	AddSubject(context.Context, *AddSubjectReq) (*AddSubjectReply, error)
+	// Update subject
+	UpdateSubject(context.Context, *UpdateSubjectReq) (*UpdateSubjectReply, error)
+	// Get subject by name
+	GetSubject(context.Context, *GetSubjectReq) (*SubjectDetail, error)
+	// List and search subjects
+	ListSubject(context.Context, *ListSubjectReq) (*ListSubjectReply, error)
+	// Delete subject
+	DelSubject(context.Context, *DelSubjectReq) (*DelSubjectReply, error)
 }
 
-func (*UnimplementedINFRAAPPLYServer) ListInfraApply(ctx context.Context, req *ListInfraApplyReq) (*ListInfraApplyReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListInfraApply not implemented")
+// UnimplementedSUBJECTServer can be embedded to have forward compatible implementations.
+type UnimplementedSUBJECTServer struct {
 }
-func (*UnimplementedINFRAAPPLYServer) AddInfraApply(ctx context.Context, req *AddInfraApplyReq) (*AddInfraApplyReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddInfraApply not implemented")
+
+func (*UnimplementedSUBJECTServer) AddSubject(ctx context.Context, req *AddSubjectReq) (*AddSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubject not implemented")
 }
-func (*UnimplementedINFRAAPPLYServer) UpdateInfraApply(ctx context.Context, req *UpdateInfraApplyReq) (*UpdateInfraApplyReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateInfraApply not implemented")
+func (*UnimplementedSUBJECTServer) UpdateSubject(ctx context.Context, req *UpdateSubjectReq) (*UpdateSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubject not implemented")
 }
-func (*UnimplementedINFRAAPPLYServer) DelInfraApply(ctx context.Context, req *DelInfraApplyReq) (*DelInfraApplyReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelInfraApply not implemented")
+func (*UnimplementedSUBJECTServer) GetSubject(ctx context.Context, req *GetSubjectReq) (*SubjectDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubject not implemented")
+}
+func (*UnimplementedSUBJECTServer) ListSubject(ctx context.Context, req *ListSubjectReq) (*ListSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubject not implemented")
+}
+func (*UnimplementedSUBJECTServer) DelSubject(ctx context.Context, req *DelSubjectReq) (*DelSubjectReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelSubject not implemented")
 }
 
-func RegisterINFRAAPPLYServer(s *grpc.Server, srv INFRAAPPLYServer) {
-	s.RegisterService(&_INFRAAPPLY_serviceDesc, srv)
+func RegisterSUBJECTServer(s *grpc.Server, srv SUBJECTServer) {
+	s.RegisterService(&_SUBJECT_serviceDesc, srv)
 }
 
-func _INFRAAPPLY_ListInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInfraApplyReq)
+func _SUBJECT_AddSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubjectReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(INFRAAPPLYServer).ListInfraApply(ctx, in)
+		return srv.(SUBJECTServer).AddSubject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/InfraApply.INFRAAPPLY/ListInfraApply",
+		FullMethod: "/InfraApply.SUBJECT/AddSubject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(INFRAAPPLYServer).ListInfraApply(ctx, req.(*ListInfraApplyReq))
+		return srv.(SUBJECTServer).AddSubject(ctx, req.(*AddSubjectReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _INFRAAPPLY_AddInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddInfraApplyReq)
+func _SUBJECT_UpdateSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubjectReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(INFRAAPPLYServer).AddInfraApply(ctx, in)
+		return srv.(SUBJECTServer).UpdateSubject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/InfraApply.INFRAAPPLY/AddInfraApply",
+		FullMethod: "/InfraApply.SUBJECT/UpdateSubject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(INFRAAPPLYServer).AddInfraApply(ctx, req.(*AddInfraApplyReq))
+		return srv.(SUBJECTServer).UpdateSubject(ctx, req.(*UpdateSubjectReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _INFRAAPPLY_UpdateInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateInfraApplyReq)
+func _SUBJECT_GetSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubjectReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(INFRAAPPLYServer).UpdateInfraApply(ctx, in)
+		return srv.(SUBJECTServer).GetSubject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/InfraApply.INFRAAPPLY/UpdateInfraApply",
+		FullMethod: "/InfraApply.SUBJECT/GetSubject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(INFRAAPPLYServer).UpdateInfraApply(ctx, req.(*UpdateInfraApplyReq))
+		return srv.(SUBJECTServer).GetSubject(ctx, req.(*GetSubjectReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _INFRAAPPLY_DelInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelInfraApplyReq)
+func _SUBJECT_ListSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubjectReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(INFRAAPPLYServer).DelInfraApply(ctx, in)
+		return srv.(SUBJECTServer).ListSubject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/InfraApply.INFRAAPPLY/DelInfraApply",
+		FullMethod: "/InfraApply.SUBJECT/ListSubject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(INFRAAPPLYServer).DelInfraApply(ctx, req.(*DelInfraApplyReq))
+		return srv.(SUBJECTServer).ListSubject(ctx, req.(*ListSubjectReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _INFRAAPPLY_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "InfraApply.INFRAAPPLY",
-	HandlerType: (*INFRAAPPLYServer)(nil),
+func _SUBJECT_DelSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelSubjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SUBJECTServer).DelSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.SUBJECT/DelSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SUBJECTServer).DelSubject(ctx, req.(*DelSubjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SUBJECT_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "InfraApply.SUBJECT",
+	HandlerType: (*SUBJECTServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "ListInfraApply",
-			Handler:    _INFRAAPPLY_ListInfraApply_Handler,
+			MethodName: "AddSubject",
+			Handler:    _SUBJECT_AddSubject_Handler,
 		},
 		{
-			MethodName: "AddInfraApply",
-			Handler:    _INFRAAPPLY_AddInfraApply_Handler,
+			MethodName: "UpdateSubject",
+			Handler:    _SUBJECT_UpdateSubject_Handler,
 		},
 		{
-			MethodName: "UpdateInfraApply",
-			Handler:    _INFRAAPPLY_UpdateInfraApply_Handler,
+			MethodName: "GetSubject",
+			Handler:    _SUBJECT_GetSubject_Handler,
 		},
 		{
-			MethodName: "DelInfraApply",
-			Handler:    _INFRAAPPLY_DelInfraApply_Handler,
+			MethodName: "ListSubject",
+			Handler:    _SUBJECT_ListSubject_Handler,
+		},
+		{
+			MethodName: "DelSubject",
+			Handler:    _SUBJECT_DelSubject_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
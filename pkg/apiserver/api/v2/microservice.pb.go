@@ -0,0 +1,1260 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: microservice.proto
+
+package v2
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// page message struct
+type ModelPage struct {
+	PageIdx              int32    `protobuf:"varint,1,opt,name=pageIdx,proto3" json:"pageIdx,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Total                int32    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModelPage) Reset()         { *m = ModelPage{} }
+func (m *ModelPage) String() string { return proto.CompactTextString(m) }
+func (*ModelPage) ProtoMessage()    {}
+func (*ModelPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{0}
+}
+
+func (m *ModelPage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModelPage.Unmarshal(m, b)
+}
+func (m *ModelPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModelPage.Marshal(b, m, deterministic)
+}
+func (m *ModelPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelPage.Merge(m, src)
+}
+func (m *ModelPage) XXX_Size() int {
+	return xxx_messageInfo_ModelPage.Size(m)
+}
+func (m *ModelPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelPage proto.InternalMessageInfo
+
+func (m *ModelPage) GetPageIdx() int32 {
+	if m != nil {
+		return m.PageIdx
+	}
+	return 0
+}
+
+func (m *ModelPage) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ModelPage) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// List
+type ListInfraApplyReq struct {
+	PageIdx              int32    `protobuf:"varint,1,opt,name=pageIdx,proto3" json:"pageIdx,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Search               string   `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	ExtendsID            int32    `protobuf:"varint,4,opt,name=extendsID,proto3" json:"extendsID,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Applyer              string   `protobuf:"bytes,6,opt,name=applyer,proto3" json:"applyer,omitempty"`
+	DeviceCode           string   `protobuf:"bytes,7,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInfraApplyReq) Reset()         { *m = ListInfraApplyReq{} }
+func (m *ListInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*ListInfraApplyReq) ProtoMessage()    {}
+func (*ListInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{1}
+}
+
+func (m *ListInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInfraApplyReq.Unmarshal(m, b)
+}
+func (m *ListInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *ListInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInfraApplyReq.Merge(m, src)
+}
+func (m *ListInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_ListInfraApplyReq.Size(m)
+}
+func (m *ListInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInfraApplyReq proto.InternalMessageInfo
+
+func (m *ListInfraApplyReq) GetPageIdx() int32 {
+	if m != nil {
+		return m.PageIdx
+	}
+	return 0
+}
+
+func (m *ListInfraApplyReq) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListInfraApplyReq) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ListInfraApplyReq) GetExtendsID() int32 {
+	if m != nil {
+		return m.ExtendsID
+	}
+	return 0
+}
+
+func (m *ListInfraApplyReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListInfraApplyReq) GetApplyer() string {
+	if m != nil {
+		return m.Applyer
+	}
+	return ""
+}
+
+func (m *ListInfraApplyReq) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+type ListInfraApplyReply struct {
+	Page                 *ModelPage          `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Record               []*InfraApplyDetail `protobuf:"bytes,2,rep,name=record,proto3" json:"record,omitempty"`
+	Exhausted            bool                `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListInfraApplyReply) Reset()         { *m = ListInfraApplyReply{} }
+func (m *ListInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*ListInfraApplyReply) ProtoMessage()    {}
+func (*ListInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{2}
+}
+
+func (m *ListInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInfraApplyReply.Unmarshal(m, b)
+}
+func (m *ListInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *ListInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInfraApplyReply.Merge(m, src)
+}
+func (m *ListInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_ListInfraApplyReply.Size(m)
+}
+func (m *ListInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInfraApplyReply proto.InternalMessageInfo
+
+func (m *ListInfraApplyReply) GetPage() *ModelPage {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *ListInfraApplyReply) GetRecord() []*InfraApplyDetail {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *ListInfraApplyReply) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
+	}
+	return false
+}
+
+type InfraApplyDetail struct {
+	ID                   int32                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	DeviceCode           string               `protobuf:"bytes,2,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
+	Applyer              string               `protobuf:"bytes,3,opt,name=applyer,proto3" json:"applyer,omitempty"`
+	Status               string               `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SubjectName          string               `protobuf:"bytes,5,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
+	ReviewId             string               `protobuf:"bytes,6,opt,name=reviewId,proto3" json:"reviewId,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	ReviewTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=reviewTime,proto3" json:"reviewTime,omitempty"`
+	Comment              string               `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	WorkflowID           int32                `protobuf:"varint,10,opt,name=workflowID,proto3" json:"workflowID,omitempty"`
+	Stage                int32                `protobuf:"varint,11,opt,name=stage,proto3" json:"stage,omitempty"`
+	PolicyRule           string               `protobuf:"bytes,12,opt,name=policyRule,proto3" json:"policyRule,omitempty"`
+	ExtendsID            int32                `protobuf:"varint,13,opt,name=extendsID,proto3" json:"extendsID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InfraApplyDetail) Reset()         { *m = InfraApplyDetail{} }
+func (m *InfraApplyDetail) String() string { return proto.CompactTextString(m) }
+func (*InfraApplyDetail) ProtoMessage()    {}
+func (*InfraApplyDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{3}
+}
+
+func (m *InfraApplyDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfraApplyDetail.Unmarshal(m, b)
+}
+func (m *InfraApplyDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfraApplyDetail.Marshal(b, m, deterministic)
+}
+func (m *InfraApplyDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfraApplyDetail.Merge(m, src)
+}
+func (m *InfraApplyDetail) XXX_Size() int {
+	return xxx_messageInfo_InfraApplyDetail.Size(m)
+}
+func (m *InfraApplyDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfraApplyDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfraApplyDetail proto.InternalMessageInfo
+
+func (m *InfraApplyDetail) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *InfraApplyDetail) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *InfraApplyDetail) GetApplyer() string {
+	if m != nil {
+		return m.Applyer
+	}
+	return ""
+}
+
+func (m *InfraApplyDetail) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *InfraApplyDetail) GetSubjectName() string {
+	if m != nil {
+		return m.SubjectName
+	}
+	return ""
+}
+
+func (m *InfraApplyDetail) GetReviewId() string {
+	if m != nil {
+		return m.ReviewId
+	}
+	return ""
+}
+
+func (m *InfraApplyDetail) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *InfraApplyDetail) GetReviewTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReviewTime
+	}
+	return nil
+}
+
+func (m *InfraApplyDetail) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *InfraApplyDetail) GetWorkflowID() int32 {
+	if m != nil {
+		return m.WorkflowID
+	}
+	return 0
+}
+
+func (m *InfraApplyDetail) GetStage() int32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
+}
+
+func (m *InfraApplyDetail) GetPolicyRule() string {
+	if m != nil {
+		return m.PolicyRule
+	}
+	return ""
+}
+
+func (m *InfraApplyDetail) GetExtendsID() int32 {
+	if m != nil {
+		return m.ExtendsID
+	}
+	return 0
+}
+
+// Get
+type GetInfraApplyReq struct {
+	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetInfraApplyReq) Reset()         { *m = GetInfraApplyReq{} }
+func (m *GetInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*GetInfraApplyReq) ProtoMessage()    {}
+func (*GetInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{4}
+}
+
+func (m *GetInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInfraApplyReq.Unmarshal(m, b)
+}
+func (m *GetInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *GetInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInfraApplyReq.Merge(m, src)
+}
+func (m *GetInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_GetInfraApplyReq.Size(m)
+}
+func (m *GetInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInfraApplyReq proto.InternalMessageInfo
+
+func (m *GetInfraApplyReq) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+// Add
+type AddInfraApplyReq struct {
+	DeviceCode           string               `protobuf:"bytes,1,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`
+	Uid                  string               `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	SubjectName          string               `protobuf:"bytes,3,opt,name=subjectName,proto3" json:"subjectName,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AddInfraApplyReq) Reset()         { *m = AddInfraApplyReq{} }
+func (m *AddInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*AddInfraApplyReq) ProtoMessage()    {}
+func (*AddInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{5}
+}
+
+func (m *AddInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInfraApplyReq.Unmarshal(m, b)
+}
+func (m *AddInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *AddInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInfraApplyReq.Merge(m, src)
+}
+func (m *AddInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_AddInfraApplyReq.Size(m)
+}
+func (m *AddInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddInfraApplyReq proto.InternalMessageInfo
+
+func (m *AddInfraApplyReq) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReq) GetSubjectName() string {
+	if m != nil {
+		return m.SubjectName
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReq) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type AddInfraApplyReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ID                   int32    `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PolicyRule           string   `protobuf:"bytes,4,opt,name=policyRule,proto3" json:"policyRule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddInfraApplyReply) Reset()         { *m = AddInfraApplyReply{} }
+func (m *AddInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*AddInfraApplyReply) ProtoMessage()    {}
+func (*AddInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{6}
+}
+
+func (m *AddInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInfraApplyReply.Unmarshal(m, b)
+}
+func (m *AddInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *AddInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInfraApplyReply.Merge(m, src)
+}
+func (m *AddInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_AddInfraApplyReply.Size(m)
+}
+func (m *AddInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddInfraApplyReply proto.InternalMessageInfo
+
+func (m *AddInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReply) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AddInfraApplyReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *AddInfraApplyReply) GetPolicyRule() string {
+	if m != nil {
+		return m.PolicyRule
+	}
+	return ""
+}
+
+// Update
+type UpdateInfraApplyReq struct {
+	ID                   int32                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status               string               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpdateInfraApplyReq) Reset()         { *m = UpdateInfraApplyReq{} }
+func (m *UpdateInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfraApplyReq) ProtoMessage()    {}
+func (*UpdateInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{7}
+}
+
+func (m *UpdateInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateInfraApplyReq.Unmarshal(m, b)
+}
+func (m *UpdateInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *UpdateInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfraApplyReq.Merge(m, src)
+}
+func (m *UpdateInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_UpdateInfraApplyReq.Size(m)
+}
+func (m *UpdateInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfraApplyReq proto.InternalMessageInfo
+
+func (m *UpdateInfraApplyReq) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *UpdateInfraApplyReq) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *UpdateInfraApplyReq) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type UpdateInfraApplyReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateInfraApplyReply) Reset()         { *m = UpdateInfraApplyReply{} }
+func (m *UpdateInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfraApplyReply) ProtoMessage()    {}
+func (*UpdateInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{8}
+}
+
+func (m *UpdateInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateInfraApplyReply.Unmarshal(m, b)
+}
+func (m *UpdateInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *UpdateInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfraApplyReply.Merge(m, src)
+}
+func (m *UpdateInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_UpdateInfraApplyReply.Size(m)
+}
+func (m *UpdateInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfraApplyReply proto.InternalMessageInfo
+
+func (m *UpdateInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *UpdateInfraApplyReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// Extend
+type ExtendInfraApplyReq struct {
+	ID                   int32                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Uid                  string               `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	Comment              string               `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExtendInfraApplyReq) Reset()         { *m = ExtendInfraApplyReq{} }
+func (m *ExtendInfraApplyReq) String() string { return proto.CompactTextString(m) }
+func (*ExtendInfraApplyReq) ProtoMessage()    {}
+func (*ExtendInfraApplyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{9}
+}
+
+func (m *ExtendInfraApplyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendInfraApplyReq.Unmarshal(m, b)
+}
+func (m *ExtendInfraApplyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendInfraApplyReq.Marshal(b, m, deterministic)
+}
+func (m *ExtendInfraApplyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendInfraApplyReq.Merge(m, src)
+}
+func (m *ExtendInfraApplyReq) XXX_Size() int {
+	return xxx_messageInfo_ExtendInfraApplyReq.Size(m)
+}
+func (m *ExtendInfraApplyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendInfraApplyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendInfraApplyReq proto.InternalMessageInfo
+
+func (m *ExtendInfraApplyReq) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ExtendInfraApplyReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ExtendInfraApplyReq) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *ExtendInfraApplyReq) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type ExtendInfraApplyReply struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ID                   int32    `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PolicyRule           string   `protobuf:"bytes,4,opt,name=policyRule,proto3" json:"policyRule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtendInfraApplyReply) Reset()         { *m = ExtendInfraApplyReply{} }
+func (m *ExtendInfraApplyReply) String() string { return proto.CompactTextString(m) }
+func (*ExtendInfraApplyReply) ProtoMessage()    {}
+func (*ExtendInfraApplyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{10}
+}
+
+func (m *ExtendInfraApplyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendInfraApplyReply.Unmarshal(m, b)
+}
+func (m *ExtendInfraApplyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendInfraApplyReply.Marshal(b, m, deterministic)
+}
+func (m *ExtendInfraApplyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendInfraApplyReply.Merge(m, src)
+}
+func (m *ExtendInfraApplyReply) XXX_Size() int {
+	return xxx_messageInfo_ExtendInfraApplyReply.Size(m)
+}
+func (m *ExtendInfraApplyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendInfraApplyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendInfraApplyReply proto.InternalMessageInfo
+
+func (m *ExtendInfraApplyReply) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *ExtendInfraApplyReply) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ExtendInfraApplyReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ExtendInfraApplyReply) GetPolicyRule() string {
+	if m != nil {
+		return m.PolicyRule
+	}
+	return ""
+}
+
+// Review
+type ListInfraApplyReviewReq struct {
+	ID                   int32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListInfraApplyReviewReq) Reset()         { *m = ListInfraApplyReviewReq{} }
+func (m *ListInfraApplyReviewReq) String() string { return proto.CompactTextString(m) }
+func (*ListInfraApplyReviewReq) ProtoMessage()    {}
+func (*ListInfraApplyReviewReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{11}
+}
+
+func (m *ListInfraApplyReviewReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInfraApplyReviewReq.Unmarshal(m, b)
+}
+func (m *ListInfraApplyReviewReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInfraApplyReviewReq.Marshal(b, m, deterministic)
+}
+func (m *ListInfraApplyReviewReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInfraApplyReviewReq.Merge(m, src)
+}
+func (m *ListInfraApplyReviewReq) XXX_Size() int {
+	return xxx_messageInfo_ListInfraApplyReviewReq.Size(m)
+}
+func (m *ListInfraApplyReviewReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInfraApplyReviewReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInfraApplyReviewReq proto.InternalMessageInfo
+
+func (m *ListInfraApplyReviewReq) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+type InfraApplyReviewDetail struct {
+	Stage                int32                `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Reviewer             string               `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Decision             string               `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"`
+	Comment              string               `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	ReviewTime           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=reviewTime,proto3" json:"reviewTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InfraApplyReviewDetail) Reset()         { *m = InfraApplyReviewDetail{} }
+func (m *InfraApplyReviewDetail) String() string { return proto.CompactTextString(m) }
+func (*InfraApplyReviewDetail) ProtoMessage()    {}
+func (*InfraApplyReviewDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{12}
+}
+
+func (m *InfraApplyReviewDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfraApplyReviewDetail.Unmarshal(m, b)
+}
+func (m *InfraApplyReviewDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfraApplyReviewDetail.Marshal(b, m, deterministic)
+}
+func (m *InfraApplyReviewDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfraApplyReviewDetail.Merge(m, src)
+}
+func (m *InfraApplyReviewDetail) XXX_Size() int {
+	return xxx_messageInfo_InfraApplyReviewDetail.Size(m)
+}
+func (m *InfraApplyReviewDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfraApplyReviewDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfraApplyReviewDetail proto.InternalMessageInfo
+
+func (m *InfraApplyReviewDetail) GetStage() int32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
+}
+
+func (m *InfraApplyReviewDetail) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
+	}
+	return ""
+}
+
+func (m *InfraApplyReviewDetail) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
+}
+
+func (m *InfraApplyReviewDetail) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *InfraApplyReviewDetail) GetReviewTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReviewTime
+	}
+	return nil
+}
+
+type ListInfraApplyReviewReply struct {
+	Record               []*InfraApplyReviewDetail `protobuf:"bytes,1,rep,name=record,proto3" json:"record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListInfraApplyReviewReply) Reset()         { *m = ListInfraApplyReviewReply{} }
+func (m *ListInfraApplyReviewReply) String() string { return proto.CompactTextString(m) }
+func (*ListInfraApplyReviewReply) ProtoMessage()    {}
+func (*ListInfraApplyReviewReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cc2ddde859f443c, []int{13}
+}
+
+func (m *ListInfraApplyReviewReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInfraApplyReviewReply.Unmarshal(m, b)
+}
+func (m *ListInfraApplyReviewReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInfraApplyReviewReply.Marshal(b, m, deterministic)
+}
+func (m *ListInfraApplyReviewReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInfraApplyReviewReply.Merge(m, src)
+}
+func (m *ListInfraApplyReviewReply) XXX_Size() int {
+	return xxx_messageInfo_ListInfraApplyReviewReply.Size(m)
+}
+func (m *ListInfraApplyReviewReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInfraApplyReviewReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInfraApplyReviewReply proto.InternalMessageInfo
+
+func (m *ListInfraApplyReviewReply) GetRecord() []*InfraApplyReviewDetail {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ModelPage)(nil), "InfraApply.v2.ModelPage")
+	proto.RegisterType((*ListInfraApplyReq)(nil), "InfraApply.v2.ListInfraApplyReq")
+	proto.RegisterType((*ListInfraApplyReply)(nil), "InfraApply.v2.ListInfraApplyReply")
+	proto.RegisterType((*InfraApplyDetail)(nil), "InfraApply.v2.InfraApplyDetail")
+	proto.RegisterType((*GetInfraApplyReq)(nil), "InfraApply.v2.GetInfraApplyReq")
+	proto.RegisterType((*AddInfraApplyReq)(nil), "InfraApply.v2.AddInfraApplyReq")
+	proto.RegisterType((*AddInfraApplyReply)(nil), "InfraApply.v2.AddInfraApplyReply")
+	proto.RegisterType((*UpdateInfraApplyReq)(nil), "InfraApply.v2.UpdateInfraApplyReq")
+	proto.RegisterType((*UpdateInfraApplyReply)(nil), "InfraApply.v2.UpdateInfraApplyReply")
+	proto.RegisterType((*ExtendInfraApplyReq)(nil), "InfraApply.v2.ExtendInfraApplyReq")
+	proto.RegisterType((*ExtendInfraApplyReply)(nil), "InfraApply.v2.ExtendInfraApplyReply")
+	proto.RegisterType((*ListInfraApplyReviewReq)(nil), "InfraApply.v2.ListInfraApplyReviewReq")
+	proto.RegisterType((*InfraApplyReviewDetail)(nil), "InfraApply.v2.InfraApplyReviewDetail")
+	proto.RegisterType((*ListInfraApplyReviewReply)(nil), "InfraApply.v2.ListInfraApplyReviewReply")
+}
+
+func init() { proto.RegisterFile("microservice.proto", fileDescriptor_9cc2ddde859f443c) }
+
+var fileDescriptor_9cc2ddde859f443c = []byte{
+	// 907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xc1, 0x6e, 0xe4, 0x44,
+	0x10, 0x55, 0xdb, 0x93, 0xd9, 0xa4, 0x86, 0xac, 0x86, 0xce, 0x6e, 0x30, 0xd6, 0x8a, 0x0d, 0xad,
+	0x05, 0x42, 0xb4, 0x78, 0xa4, 0x89, 0x10, 0x22, 0x12, 0x87, 0xc0, 0x40, 0x64, 0x69, 0x59, 0x45,
+	0x66, 0x11, 0x62, 0x6f, 0x8e, 0xdd, 0xc9, 0x1a, 0x3c, 0xd3, 0xc6, 0xee, 0x49, 0x32, 0x1c, 0x91,
+	0x10, 0x07, 0xc4, 0x29, 0x37, 0x8e, 0xf0, 0x13, 0x1c, 0xf8, 0x09, 0xc4, 0x2f, 0xf0, 0x1d, 0x08,
+	0x75, 0xbb, 0xc7, 0x76, 0xb7, 0x67, 0x32, 0x09, 0x48, 0xdc, 0x5c, 0xdd, 0x55, 0xee, 0x57, 0xaf,
+	0xaa, 0x5e, 0x01, 0x1e, 0x27, 0x51, 0xce, 0x0a, 0x9a, 0x9f, 0x27, 0x11, 0xf5, 0xb2, 0x9c, 0x71,
+	0x86, 0x37, 0xfd, 0xc9, 0x69, 0x1e, 0x1e, 0x66, 0x59, 0x3a, 0xf3, 0xce, 0x87, 0xee, 0x83, 0x33,
+	0xc6, 0xce, 0x52, 0x3a, 0x08, 0xb3, 0x64, 0x10, 0x4e, 0x26, 0x8c, 0x87, 0x3c, 0x61, 0x93, 0xa2,
+	0x74, 0x76, 0x1f, 0xaa, 0x5b, 0x69, 0x9d, 0x4c, 0x4f, 0x07, 0x3c, 0x19, 0xd3, 0x82, 0x87, 0xe3,
+	0xac, 0x74, 0x20, 0x5f, 0xc0, 0xc6, 0xa7, 0x2c, 0xa6, 0xe9, 0x71, 0x78, 0x46, 0xb1, 0x03, 0x77,
+	0xb2, 0xf0, 0x8c, 0xfa, 0xf1, 0xa5, 0x83, 0x76, 0xd0, 0xee, 0x5a, 0x30, 0x37, 0xb1, 0x0b, 0xeb,
+	0xe2, 0xf3, 0xb3, 0xe4, 0x5b, 0xea, 0x58, 0xf2, 0xaa, 0xb2, 0xf1, 0x3d, 0x58, 0xe3, 0x8c, 0x87,
+	0xa9, 0x63, 0xcb, 0x8b, 0xd2, 0x20, 0x7f, 0x20, 0x78, 0xf9, 0x49, 0x52, 0xf0, 0x1a, 0x6d, 0x40,
+	0xbf, 0xf9, 0x97, 0x2f, 0x6c, 0x43, 0xb7, 0xa0, 0x61, 0x1e, 0xbd, 0x90, 0x4f, 0x6c, 0x04, 0xca,
+	0xc2, 0x0f, 0x60, 0x83, 0x5e, 0x72, 0x3a, 0x89, 0x0b, 0x7f, 0xe4, 0x74, 0x64, 0x50, 0x7d, 0x20,
+	0xa3, 0x78, 0xc8, 0xa7, 0x85, 0xb3, 0xa6, 0xa2, 0xa4, 0x25, 0x30, 0x84, 0x02, 0x0f, 0xcd, 0x9d,
+	0xae, 0xbc, 0x98, 0x9b, 0xf8, 0x35, 0x80, 0x98, 0x0a, 0xaa, 0x3f, 0x62, 0x31, 0x75, 0xee, 0xc8,
+	0xcb, 0xc6, 0x09, 0xf9, 0x19, 0xc1, 0x96, 0x99, 0x53, 0x96, 0xce, 0xf0, 0x63, 0xe8, 0x08, 0xac,
+	0x32, 0xa5, 0xde, 0xd0, 0xf1, 0xb4, 0x0a, 0x79, 0x15, 0xbf, 0x81, 0xf4, 0xc2, 0xef, 0x41, 0x37,
+	0xa7, 0x11, 0xcb, 0x63, 0xc7, 0xda, 0xb1, 0x77, 0x7b, 0xc3, 0x87, 0x86, 0x7f, 0x6d, 0x8d, 0x28,
+	0x0f, 0x93, 0x34, 0x50, 0xee, 0x65, 0xba, 0x2f, 0xc2, 0x69, 0xc1, 0x69, 0x2c, 0x99, 0x58, 0x0f,
+	0xea, 0x03, 0xf2, 0x9b, 0x0d, 0x7d, 0x33, 0x14, 0xdf, 0x05, 0xcb, 0x1f, 0x29, 0xaa, 0x2d, 0x7f,
+	0x64, 0x64, 0x68, 0x99, 0x19, 0x36, 0xb9, 0xb1, 0x75, 0x6e, 0x6a, 0x36, 0x3b, 0x1a, 0x9b, 0x3b,
+	0xd0, 0x2b, 0xa6, 0x27, 0x5f, 0xd1, 0x88, 0x3f, 0x0d, 0xc7, 0x54, 0x51, 0xdd, 0x3c, 0x12, 0x95,
+	0xcd, 0xe9, 0x79, 0x42, 0x2f, 0xfc, 0x58, 0x11, 0x5e, 0xd9, 0xf8, 0x00, 0x80, 0x5e, 0x66, 0x49,
+	0x4e, 0x9f, 0x25, 0xe3, 0x92, 0xf1, 0xde, 0xd0, 0xf5, 0xca, 0xa6, 0xf5, 0xe6, 0x4d, 0xeb, 0x3d,
+	0x9b, 0x37, 0x6d, 0xd0, 0xf0, 0x16, 0xb1, 0xe5, 0x7f, 0x64, 0xec, 0xfa, 0xea, 0xd8, 0xda, 0x5b,
+	0xe4, 0x19, 0xb1, 0xf1, 0x98, 0x4e, 0xb8, 0xb3, 0x51, 0xe6, 0xa9, 0x4c, 0xc1, 0xd0, 0x05, 0xcb,
+	0xbf, 0x3e, 0x4d, 0xd9, 0x85, 0x3f, 0x72, 0x40, 0x32, 0xd7, 0x38, 0x11, 0xdd, 0x5e, 0x70, 0x51,
+	0xec, 0x5e, 0xd9, 0xed, 0xd2, 0x10, 0x51, 0x19, 0x4b, 0x93, 0x68, 0x16, 0x4c, 0x53, 0xea, 0xbc,
+	0x54, 0xf2, 0x5a, 0x9f, 0xe8, 0x9d, 0xba, 0x69, 0x74, 0x2a, 0x21, 0xd0, 0x3f, 0xa2, 0xc6, 0xa4,
+	0x18, 0x95, 0x23, 0xbf, 0x20, 0xe8, 0x1f, 0xc6, 0xb1, 0xee, 0xa4, 0x97, 0x13, 0xb5, 0xca, 0xd9,
+	0x07, 0x7b, 0x9a, 0xc4, 0xaa, 0xce, 0xe2, 0xd3, 0x2c, 0x97, 0xdd, 0x2e, 0x97, 0x5e, 0x92, 0xce,
+	0x6d, 0x4a, 0x42, 0x38, 0x60, 0x03, 0xa3, 0x18, 0x8f, 0x6d, 0xd1, 0xf0, 0xc5, 0x34, 0xe5, 0x0a,
+	0xa1, 0xb2, 0x54, 0x8a, 0x56, 0xd5, 0x9c, 0x75, 0x8b, 0xd9, 0x5a, 0x8b, 0xe9, 0xe4, 0x76, 0x4c,
+	0x72, 0xc9, 0x0c, 0xb6, 0x3e, 0xcf, 0xe2, 0x90, 0xd3, 0x6b, 0x19, 0x6c, 0xfc, 0xde, 0xd2, 0x7e,
+	0xaf, 0x27, 0x6c, 0xdf, 0x2a, 0xe1, 0x23, 0xb8, 0xdf, 0x7e, 0xfa, 0xba, 0x9c, 0x97, 0x80, 0x20,
+	0x3f, 0x21, 0xd8, 0xfa, 0x58, 0x36, 0xc4, 0xf5, 0x49, 0xb4, 0x2b, 0xfa, 0x1f, 0xe0, 0x37, 0xc7,
+	0xa0, 0xa3, 0x8d, 0x01, 0xb9, 0x80, 0xfb, 0x6d, 0x38, 0xff, 0x47, 0x31, 0xdf, 0x86, 0x57, 0x4c,
+	0x89, 0x15, 0x53, 0xbb, 0x68, 0x24, 0x7e, 0x47, 0xb0, 0x6d, 0xfa, 0x29, 0xdd, 0xab, 0xa6, 0x14,
+	0x35, 0xa7, 0xb4, 0x52, 0x22, 0x9a, 0x2b, 0x06, 0x2b, 0x5b, 0xdc, 0xc5, 0x34, 0x4a, 0x8a, 0x84,
+	0x4d, 0x14, 0xe2, 0xca, 0x5e, 0x4e, 0x93, 0xa1, 0x41, 0x6b, 0xb7, 0xd1, 0x20, 0xf2, 0x1c, 0x5e,
+	0x5d, 0x9c, 0xa9, 0xa0, 0xf9, 0x83, 0x6a, 0x49, 0x20, 0xb9, 0x24, 0xde, 0x58, 0xba, 0x24, 0x9a,
+	0x79, 0xcf, 0x57, 0xc5, 0xf0, 0xef, 0x2e, 0x80, 0xff, 0xf4, 0x93, 0xe0, 0xf0, 0xf0, 0xf8, 0xf8,
+	0xc9, 0x97, 0xf8, 0x47, 0x04, 0x77, 0xf5, 0xb7, 0xf0, 0x8e, 0xf1, 0xc3, 0xd6, 0xae, 0x76, 0xc9,
+	0x0a, 0x8f, 0x2c, 0x9d, 0x91, 0xfd, 0xef, 0xfe, 0xfc, 0xeb, 0xca, 0x7a, 0x87, 0xec, 0x0e, 0x0c,
+	0x78, 0xd5, 0xdb, 0x03, 0x3d, 0xec, 0x00, 0xed, 0xe1, 0xef, 0x11, 0x6c, 0x6a, 0x7a, 0x87, 0xcd,
+	0x15, 0x68, 0xaa, 0xa1, 0xbb, 0x6a, 0x47, 0x92, 0xa1, 0x04, 0xf2, 0x98, 0xbc, 0xb5, 0x1c, 0xc8,
+	0x11, 0x35, 0x70, 0xfc, 0x80, 0x60, 0x53, 0x93, 0xab, 0x16, 0x0e, 0x53, 0x70, 0xdd, 0xd7, 0xaf,
+	0x77, 0x10, 0x94, 0xdc, 0x00, 0x89, 0x16, 0x25, 0x90, 0x5c, 0x21, 0xe8, 0x9b, 0x3a, 0x82, 0x4d,
+	0xfe, 0x17, 0x68, 0x9c, 0xfb, 0x68, 0xa5, 0x8f, 0x80, 0xf4, 0xae, 0x84, 0x34, 0x20, 0x7b, 0xcb,
+	0x21, 0x99, 0x81, 0x73, 0x54, 0xa6, 0x08, 0xb4, 0x50, 0x2d, 0x10, 0x2d, 0xf7, 0xd1, 0x4a, 0x9f,
+	0x1b, 0xa2, 0x32, 0x03, 0x05, 0xaa, 0x5f, 0x11, 0xdc, 0x5b, 0x34, 0x37, 0xf8, 0xcd, 0x15, 0xfd,
+	0xaa, 0x64, 0xc4, 0xdd, 0xbd, 0x91, 0x9f, 0x40, 0xf8, 0xbe, 0x44, 0xb8, 0x4f, 0xbc, 0x9b, 0x76,
+	0x77, 0x19, 0x7c, 0x80, 0xf6, 0x3e, 0xec, 0x3c, 0xb7, 0xce, 0x87, 0x27, 0x5d, 0x29, 0x01, 0xfb,
+	0xff, 0x0c, 0x00, 0x36, 0x96, 0x17, 0xad, 0xc8, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// INFRAAPPLYClient is the client API for INFRAAPPLY service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type INFRAAPPLYClient interface {
+	// List infra apply
+	ListInfraApply(ctx context.Context, in *ListInfraApplyReq, opts ...grpc.CallOption) (*ListInfraApplyReply, error)
+	// Get infra apply by ID
+	GetInfraApply(ctx context.Context, in *GetInfraApplyReq, opts ...grpc.CallOption) (*InfraApplyDetail, error)
+	// Add infra apply
+	AddInfraApply(ctx context.Context, in *AddInfraApplyReq, opts ...grpc.CallOption) (*AddInfraApplyReply, error)
+	// Update infra apply
+	UpdateInfraApply(ctx context.Context, in *UpdateInfraApplyReq, opts ...grpc.CallOption) (*UpdateInfraApplyReply, error)
+	// Ask for a new expiry of a granted apply
+	ExtendInfraApply(ctx context.Context, in *ExtendInfraApplyReq, opts ...grpc.CallOption) (*ExtendInfraApplyReply, error)
+	// List the reviews of an apply
+	ListInfraApplyReview(ctx context.Context, in *ListInfraApplyReviewReq, opts ...grpc.CallOption) (*ListInfraApplyReviewReply, error)
+}
+
+type iNFRAAPPLYClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewINFRAAPPLYClient(cc grpc.ClientConnInterface) INFRAAPPLYClient {
+	return &iNFRAAPPLYClient{cc}
+}
+
+func (c *iNFRAAPPLYClient) ListInfraApply(ctx context.Context, in *ListInfraApplyReq, opts ...grpc.CallOption) (*ListInfraApplyReply, error) {
+	out := new(ListInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.v2.INFRAAPPLY/ListInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) GetInfraApply(ctx context.Context, in *GetInfraApplyReq, opts ...grpc.CallOption) (*InfraApplyDetail, error) {
+	out := new(InfraApplyDetail)
+	err := c.cc.Invoke(ctx, "/InfraApply.v2.INFRAAPPLY/GetInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) AddInfraApply(ctx context.Context, in *AddInfraApplyReq, opts ...grpc.CallOption) (*AddInfraApplyReply, error) {
+	out := new(AddInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.v2.INFRAAPPLY/AddInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) UpdateInfraApply(ctx context.Context, in *UpdateInfraApplyReq, opts ...grpc.CallOption) (*UpdateInfraApplyReply, error) {
+	out := new(UpdateInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.v2.INFRAAPPLY/UpdateInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) ExtendInfraApply(ctx context.Context, in *ExtendInfraApplyReq, opts ...grpc.CallOption) (*ExtendInfraApplyReply, error) {
+	out := new(ExtendInfraApplyReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.v2.INFRAAPPLY/ExtendInfraApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iNFRAAPPLYClient) ListInfraApplyReview(ctx context.Context, in *ListInfraApplyReviewReq, opts ...grpc.CallOption) (*ListInfraApplyReviewReply, error) {
+	out := new(ListInfraApplyReviewReply)
+	err := c.cc.Invoke(ctx, "/InfraApply.v2.INFRAAPPLY/ListInfraApplyReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// INFRAAPPLYServer is the server API for INFRAAPPLY service.
+type INFRAAPPLYServer interface {
+	// List infra apply
+	ListInfraApply(context.Context, *ListInfraApplyReq) (*ListInfraApplyReply, error)
+	// Get infra apply by ID
+	GetInfraApply(context.Context, *GetInfraApplyReq) (*InfraApplyDetail, error)
+	// Add infra apply
+	AddInfraApply(context.Context, *AddInfraApplyReq) (*AddInfraApplyReply, error)
+	// Update infra apply
+	UpdateInfraApply(context.Context, *UpdateInfraApplyReq) (*UpdateInfraApplyReply, error)
+	// Ask for a new expiry of a granted apply
+	ExtendInfraApply(context.Context, *ExtendInfraApplyReq) (*ExtendInfraApplyReply, error)
+	// List the reviews of an apply
+	ListInfraApplyReview(context.Context, *ListInfraApplyReviewReq) (*ListInfraApplyReviewReply, error)
+}
+
+// UnimplementedINFRAAPPLYServer can be embedded to have forward compatible implementations.
+type UnimplementedINFRAAPPLYServer struct {
+}
+
+func (*UnimplementedINFRAAPPLYServer) ListInfraApply(ctx context.Context, req *ListInfraApplyReq) (*ListInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) GetInfraApply(ctx context.Context, req *GetInfraApplyReq) (*InfraApplyDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) AddInfraApply(ctx context.Context, req *AddInfraApplyReq) (*AddInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) UpdateInfraApply(ctx context.Context, req *UpdateInfraApplyReq) (*UpdateInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) ExtendInfraApply(ctx context.Context, req *ExtendInfraApplyReq) (*ExtendInfraApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendInfraApply not implemented")
+}
+func (*UnimplementedINFRAAPPLYServer) ListInfraApplyReview(ctx context.Context, req *ListInfraApplyReviewReq) (*ListInfraApplyReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInfraApplyReview not implemented")
+}
+
+func RegisterINFRAAPPLYServer(s *grpc.Server, srv INFRAAPPLYServer) {
+	s.RegisterService(&_INFRAAPPLY_serviceDesc, srv)
+}
+
+func _INFRAAPPLY_ListInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).ListInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.v2.INFRAAPPLY/ListInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).ListInfraApply(ctx, req.(*ListInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_GetInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).GetInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.v2.INFRAAPPLY/GetInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).GetInfraApply(ctx, req.(*GetInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_AddInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).AddInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.v2.INFRAAPPLY/AddInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).AddInfraApply(ctx, req.(*AddInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_UpdateInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).UpdateInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.v2.INFRAAPPLY/UpdateInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).UpdateInfraApply(ctx, req.(*UpdateInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_ExtendInfraApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendInfraApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).ExtendInfraApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.v2.INFRAAPPLY/ExtendInfraApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).ExtendInfraApply(ctx, req.(*ExtendInfraApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _INFRAAPPLY_ListInfraApplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInfraApplyReviewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(INFRAAPPLYServer).ListInfraApplyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InfraApply.v2.INFRAAPPLY/ListInfraApplyReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(INFRAAPPLYServer).ListInfraApplyReview(ctx, req.(*ListInfraApplyReviewReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _INFRAAPPLY_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "InfraApply.v2.INFRAAPPLY",
+	HandlerType: (*INFRAAPPLYServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInfraApply",
+			Handler:    _INFRAAPPLY_ListInfraApply_Handler,
+		},
+		{
+			MethodName: "GetInfraApply",
+			Handler:    _INFRAAPPLY_GetInfraApply_Handler,
+		},
+		{
+			MethodName: "AddInfraApply",
+			Handler:    _INFRAAPPLY_AddInfraApply_Handler,
+		},
+		{
+			MethodName: "UpdateInfraApply",
+			Handler:    _INFRAAPPLY_UpdateInfraApply_Handler,
+		},
+		{
+			MethodName: "ExtendInfraApply",
+			Handler:    _INFRAAPPLY_ExtendInfraApply_Handler,
+		},
+		{
+			MethodName: "ListInfraApplyReview",
+			Handler:    _INFRAAPPLY_ListInfraApplyReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "microservice.proto",
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache keeps values by key for a while
+type Cache interface {
+	// Get returns the value of key, ok is false if it is missing or expired
+	Get(key string) (value []byte, ok bool)
+	// Set keeps value under key for ttl
+	Set(key string, value []byte, ttl time.Duration)
+	// Stats returns the counters of the cache
+	Stats() Stats
+}
+
+// Stats counts the lookups of a Cache
+type Stats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"` // entries dropped for room, the expired ones are not counted
+	Entries   int    `json:"entries"`
+}
+
+type entry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// LRU is an in-process Cache of at most size entries, the least recently used one is evicted
+// to make room. It is safe for concurrent use.
+type LRU struct {
+	size int
+
+	mu    sync.Mutex
+	ll    *list.List // front is the most recently used
+	items map[string]*list.Element
+	stats Stats
+}
+
+// NewLRU news an LRU of size entries
+func NewLRU(size int) *LRU {
+	return &LRU{size: size, ll: list.New(), items: make(map[string]*list.Element)}
+}
+
+func (c *LRU) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if !time.Now().Before(e.expiresAt) {
+		c.remove(el)
+		c.stats.Misses++
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	c.stats.Hits++
+	return e.value, true
+}
+
+func (c *LRU) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry)
+		e.value, e.expiresAt = value, expiresAt
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&entry{key: key, value: value, expiresAt: expiresAt})
+	for c.ll.Len() > c.size {
+		c.remove(c.ll.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *LRU) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.stats
+	s.Entries = c.ll.Len()
+	return s
+}
+
+func (c *LRU) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*entry).key)
+}
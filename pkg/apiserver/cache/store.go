@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Store is a Cache whose keys carry how many times the tables their values are read from were
+// written. A write moves the keys built after it away from the entries read before, which are
+// never hit again and age out of the cache.
+type Store struct {
+	cache Cache
+
+	mu      sync.Mutex
+	gens    map[string]uint64    // by tenant/table, and by table for the writes across tenants
+	written map[string]time.Time // last invalidation, keyed like gens
+}
+
+// NewStore news a Store over c
+func NewStore(c Cache) *Store {
+	return &Store{cache: c, gens: make(map[string]uint64), written: make(map[string]time.Time)}
+}
+
+// Key builds the key of parts for tenant, it changes after a write to one of tables
+func (s *Store) Key(tenant string, tables []string, parts ...string) string {
+	var b strings.Builder
+	b.WriteString(tenant)
+
+	s.mu.Lock()
+	for _, table := range tables {
+		b.WriteByte('|')
+		b.WriteString(table)
+		b.WriteByte('.')
+		b.WriteString(strconv.FormatUint(s.gens[table], 10))
+		b.WriteByte('.')
+		b.WriteString(strconv.FormatUint(s.gens[tenant+"/"+table], 10))
+	}
+	s.mu.Unlock()
+
+	for _, part := range parts {
+		b.WriteByte('|')
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// Invalidate moves the keys of table for tenant, an empty tenant moves those of every tenant
+func (s *Store) Invalidate(tenant, table string) {
+	key := table
+	if tenant != "" {
+		key = tenant + "/" + table
+	}
+	s.mu.Lock()
+	s.gens[key]++
+	s.written[key] = time.Now()
+	s.mu.Unlock()
+}
+
+// Written returns when one of tables was last invalidated for tenant, zero if never
+func (s *Store) Written(tenant string, tables []string) time.Time {
+	var last time.Time
+	s.mu.Lock()
+	for _, table := range tables {
+		for _, key := range []string{table, tenant + "/" + table} {
+			if t := s.written[key]; t.After(last) {
+				last = t
+			}
+		}
+	}
+	s.mu.Unlock()
+	return last
+}
+
+// RegisterCallbacks makes the creates, updates and deletes of db invalidate their table for the
+// tenant db is scoped to. In a transaction of common.WithTx the table is invalidated once it
+// commits, so a read before the commit is not kept under the keys built after it.
+func (s *Store) RegisterCallbacks(db *gorm.DB) {
+	db.Callback().Create().After("gorm:create").Register("cache:invalidate", s.invalidateWrite)
+	db.Callback().Update().After("gorm:update").Register("cache:invalidate", s.invalidateWrite)
+	db.Callback().Delete().After("gorm:delete").Register("cache:invalidate", s.invalidateWrite)
+}
+
+func (s *Store) invalidateWrite(scope *gorm.Scope) {
+	if scope.HasError() || scope.DB().RowsAffected == 0 {
+		return
+	}
+	tenant, _ := common.TenantOf(scope.DB())
+	table := scope.TableName()
+	common.AfterCommit(scope.DB(), func() {
+		s.Invalidate(tenant, table)
+	})
+}
+
+func (s *Store) Get(key string) ([]byte, bool) {
+	return s.cache.Get(key)
+}
+
+func (s *Store) Set(key string, value []byte, ttl time.Duration) {
+	s.cache.Set(key, value, ttl)
+}
+
+func (s *Store) Stats() Stats {
+	return s.cache.Stats()
+}
@@ -0,0 +1,283 @@
+// Package certs builds the tls configs of the listeners and of the gateway dial from TLSCfg,
+// the certificate files are polled and reloaded on change so a rotation needs no restart.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// Store holds the current certificates and pools, its getters are used by the tls configs
+type Store struct {
+	cfg config.TLSCfg
+
+	mu         sync.RWMutex
+	cert       *tls.Certificate
+	clientCert *tls.Certificate
+	clientCAs  *x509.CertPool
+	rootCAs    *x509.CertPool
+	modTimes   map[string]time.Time
+}
+
+// NewStore loads the files of cfg, an error means the server cannot start with them
+func NewStore(cfg config.TLSCfg) (*Store, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("tls CertFile and KeyFile are required")
+	}
+	if cfg.ClientCertFile == "" {
+		cfg.ClientCertFile, cfg.ClientKeyFile = cfg.CertFile, cfg.KeyFile
+	}
+	if cfg.CAFile == "" {
+		cfg.CAFile = cfg.ClientCAFile
+	}
+
+	s := &Store{cfg: cfg}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Watch reloads the files when one of them changes until stop is closed. A broken file is
+// logged and the previous certificates are kept.
+func (s *Store) Watch(stop <-chan struct{}) {
+	interval := s.cfg.ReloadInterval
+	if interval <= 0 {
+		interval = common.TLS_RELOAD_INTERVAL
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if !s.changed() {
+				continue
+			}
+			if err := s.load(); err != nil {
+				logger.Errorf("reload tls certificates: %v", err)
+				continue
+			}
+			logger.Info("tls certificates reloaded")
+		}
+	}
+}
+
+func (s *Store) files() []string {
+	var files []string
+	for _, f := range []string{s.cfg.CertFile, s.cfg.KeyFile, s.cfg.ClientCertFile, s.cfg.ClientKeyFile,
+		s.cfg.ClientCAFile, s.cfg.CAFile} {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (s *Store) changed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, f := range s.files() {
+		fi, err := os.Stat(f)
+		if err != nil {
+			// a file being replaced, try on the next tick
+			continue
+		}
+		if !fi.ModTime().Equal(s.modTimes[f]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, f := range s.files() {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return err
+		}
+		modTimes[f] = fi.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.cfg.CertFile, s.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("load server certificate: %v", err)
+	}
+	clientCert, err := tls.LoadX509KeyPair(s.cfg.ClientCertFile, s.cfg.ClientKeyFile)
+	if err != nil {
+		return fmt.Errorf("load client certificate: %v", err)
+	}
+	clientCAs, err := loadPool(s.cfg.ClientCAFile)
+	if err != nil {
+		return err
+	}
+	rootCAs, err := loadPool(s.cfg.CAFile)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.cert, s.clientCert = &cert, &clientCert
+	s.clientCAs, s.rootCAs = clientCAs, rootCAs
+	s.modTimes = modTimes
+	s.mu.Unlock()
+	return nil
+}
+
+// loadPool returns nil for no file, the system roots are used then
+func loadPool(file string) (*x509.CertPool, error) {
+	if file == "" {
+		return nil, nil
+	}
+	pem, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", file)
+	}
+	return pool, nil
+}
+
+func (s *Store) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cert, nil
+}
+
+func (s *Store) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.clientCert, nil
+}
+
+func (s *Store) pools() (clientCAs, rootCAs *x509.CertPool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.clientCAs, s.rootCAs
+}
+
+// ServerConfig returns the config of a listener with the client auth mode of auth
+func (s *Store) ServerConfig(auth string) (*tls.Config, error) {
+	minVersion, err := parseVersion(s.cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+	clientAuth, err := parseClientAuth(auth, s.cfg.ClientCAFile != "")
+	if err != nil {
+		return nil, err
+	}
+	if clientAuth >= tls.VerifyClientCertIfGiven && s.cfg.ClientCAFile == "" {
+		return nil, fmt.Errorf("tls client auth %q needs a ClientCAFile", auth)
+	}
+
+	base := &tls.Config{
+		MinVersion:     minVersion,
+		ClientAuth:     clientAuth,
+		GetCertificate: s.getCertificate,
+		NextProtos:     []string{"h2", "http/1.1"},
+	}
+	// the client CA pool is only read at the handshake through a per connection config
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		conf := base.Clone()
+		conf.GetConfigForClient = nil
+		conf.ClientCAs, _ = s.pools()
+		return conf, nil
+	}
+	return base, nil
+}
+
+// ClientConfig returns the config the gateway dials the grpc listener with
+func (s *Store) ClientConfig(serverName string) (*tls.Config, error) {
+	minVersion, err := parseVersion(s.cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+	if s.cfg.ServerName != "" {
+		serverName = s.cfg.ServerName
+	}
+
+	return &tls.Config{
+		MinVersion:           minVersion,
+		ServerName:           serverName,
+		GetClientCertificate: s.getClientCertificate,
+		// the chain is verified below against the current pool, so a CA rotation needs no restart
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return s.verifyServer(serverName, rawCerts)
+		},
+	}, nil
+}
+
+func (s *Store) verifyServer(serverName string, rawCerts [][]byte) error {
+	if len(rawCerts) == 0 {
+		return errors.New("no server certificate")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs[i] = cert
+	}
+
+	_, rootCAs := s.pools()
+	opts := x509.VerifyOptions{
+		Roots:         rootCAs,
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+func parseVersion(v string) (uint16, error) {
+	switch v {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unsupported tls MinVersion %q", v)
+	}
+}
+
+func parseClientAuth(auth string, hasCA bool) (tls.ClientAuthType, error) {
+	switch auth {
+	case "":
+		if hasCA {
+			return tls.VerifyClientCertIfGiven, nil
+		}
+		return tls.NoClientCert, nil
+	case common.TLS_AUTH_NONE:
+		return tls.NoClientCert, nil
+	case common.TLS_AUTH_REQUEST:
+		return tls.RequestClientCert, nil
+	case common.TLS_AUTH_VERIFY:
+		return tls.VerifyClientCertIfGiven, nil
+	case common.TLS_AUTH_REQUIRE:
+		return tls.RequireAndVerifyClientCert, nil
+	default:
+		return 0, fmt.Errorf("unsupported tls client auth %q", auth)
+	}
+}
@@ -2,25 +2,28 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"net"
 	"net/http"
 	"os"
+	"strings"
 
 	v1 "big-infra/pkg/apiserver/api/v1"
+	v2 "big-infra/pkg/apiserver/api/v2"
+	"big-infra/pkg/apiserver/certs"
 	"big-infra/pkg/apiserver/common"
 	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/apiserver/docs"
 	"big-infra/pkg/apiserver/service"
+	"big-infra/pkg/apiserver/util"
 
 	logger "github.com/sirupsen/logrus"
 )
 
-const (
-	grpcPort = ":5000"
-	httpPort = ":8080"
-)
-
 func main() {
 	logger.Info()
 	logger.Info("starting apiserver for TuPam")
@@ -37,25 +40,196 @@ func main() {
 		logger.Panic(err)
 	}
 
-	s := service.New(env)
+	grpcAddr := env.Cfg.GrpcSrv.Address
+	if grpcAddr == "" {
+		grpcAddr = common.GRPC_ADDRESS
+	}
+	httpAddr := env.Cfg.HttpSrv.Address
+	if httpAddr == "" {
+		httpAddr = common.HTTP_ADDRESS
+	}
+
+	var store *certs.Store
+	if env.Cfg.TLS.Enabled {
+		store, err = certs.NewStore(env.Cfg.TLS)
+		if err != nil {
+			logger.Panic(err)
+		}
+		go store.Watch(make(chan struct{}))
+	}
 
-	go s.SignalHandler()
-	clientAddr := fmt.Sprintf("localhost%s", grpcPort)
-	go StartHTTPServer(httpPort, clientAddr)
-	if err := s.Start(env.Cfg.GrpcSrv.Address); err != nil {
+	if env.Cfg.GrpcSrv.SinglePort {
+		s := service.New(env)
+		startJobs(env, s, store)
+		StartSinglePortServer(env, s, store, grpcAddr)
+		return
+	}
+
+	var opts []grpc.ServerOption
+	if store != nil {
+		tlsConf, err := store.ServerConfig(env.Cfg.TLS.ClientAuth)
+		if err != nil {
+			logger.Panic(err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConf)))
+	}
+
+	s := service.New(env, opts...)
+	startJobs(env, s, store)
+	go StartHTTPServer(env, store, httpAddr, grpcAddr)
+	if err := s.Start(grpcAddr); err != nil {
 		logger.Panic(err)
 	}
 }
 
-// start the http server
-func StartHTTPServer(addr, clientAddr string) {
+// startJobs starts the background goroutines of the server
+func startJobs(env *config.Env, s *service.GrpcService, store *certs.Store) {
+	go s.SignalHandler()
+	if env.Cfg.Log.IsPProf {
+		go StartDiagnosticsServer(env, store)
+	}
+	if env.Cfg.Reminder.Enabled {
+		reminder, err := service.NewReminder(env)
+		if err != nil {
+			logger.Panic(err)
+		}
+		go reminder.Run(context.Background())
+	}
+	if env.Cfg.Stats.Enabled {
+		go service.NewStatsRefresher(env).Run(context.Background())
+	}
+	if len(env.Cfg.MySQL.Replicas) > 0 {
+		interval := env.Cfg.MySQL.ReplicaCheck
+		if interval <= 0 {
+			interval = common.REPLICA_CHECK
+		}
+		go env.Replicas.Run(context.Background(), interval)
+	}
+}
+
+// start the http server, it serves and dials grpc with tls when store is not nil
+func StartHTTPServer(env *config.Env, store *certs.Store, addr, grpcAddr string) {
 	logger.Info("Starting HTTP Server...")
 
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	mux := runtime.NewServeMux()
-	if err := v1.RegisterINFRAAPPLYHandlerFromEndpoint(context.Background(), mux, clientAddr, opts); err != nil {
+	network, endpoint, err := util.DialAddress(grpcAddr)
+	if err != nil {
 		logger.Fatalf("failed to start HTTP server: %v", err)
 	}
-	logger.Info("HTTP Listening on %s", addr)
-	logger.Fatal(http.ListenAndServe(addr, mux))
+	dialer := func(ctx context.Context, addr string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+
+	opts := []grpc.DialOption{grpc.WithContextDialer(dialer), grpc.WithInsecure()}
+	if store != nil {
+		serverName := "localhost"
+		if network == "tcp" {
+			serverName, _, _ = net.SplitHostPort(endpoint)
+		}
+		dialConf, err := store.ClientConfig(serverName)
+		if err != nil {
+			logger.Fatalf("failed to start HTTP server: %v", err)
+		}
+		opts = []grpc.DialOption{grpc.WithContextDialer(dialer), grpc.WithTransportCredentials(credentials.NewTLS(dialConf))}
+	}
+
+	mux := newHTTPMux(env, endpoint, opts)
+
+	logger.Infof("HTTP Listening on %s", addr)
+	auth := env.Cfg.TLS.HTTPClientAuth
+	if auth == "" {
+		auth = common.TLS_AUTH_NONE
+	}
+	logger.Fatal(serveHTTP(addr, mux, store, auth))
+}
+
+// StartSinglePortServer serves grpc, grpc-web and the http gateway on addr. The gateway calls
+// the grpc service through an in-memory connection, so it still runs the interceptors.
+func StartSinglePortServer(env *config.Env, s *service.GrpcService, store *certs.Store, addr string) {
+	logger.Infof("starting grpc, grpc-web and HTTP service at: %s", addr)
+
+	pipe := util.NewPipeListener()
+	go func() {
+		if err := s.Serve(pipe); err != nil {
+			logger.Fatalf("failed to serve the gateway connection: %v", err)
+		}
+	}()
+
+	opts := []grpc.DialOption{grpc.WithContextDialer(pipe.DialContext), grpc.WithInsecure()}
+	mux := newHTTPMux(env, pipe.Addr().String(), opts)
+
+	// the grpc client certificates are checked on the shared listener
+	logger.Fatal(serveHTTP(addr, s.Handler(mux), store, env.Cfg.TLS.ClientAuth))
+}
+
+// StartDiagnosticsServer serves pprof and the runtime stats to admins on their own address,
+// keep it off the public network
+func StartDiagnosticsServer(env *config.Env, store *certs.Store) {
+	addr := env.Cfg.Log.PProfAddress
+	if addr == "" {
+		addr = common.PPROF_ADDRESS
+	}
+
+	logger.Infof("diagnostics Listening on %s", addr)
+	logger.Errorf("diagnostics server exit: %v", serveHTTP(addr, service.NewDiagnosticsHandler(env), store, common.TLS_AUTH_NONE))
+}
+
+// newHTTPMux returns the gateway to the grpc endpoint along with the plain http handlers
+func newHTTPMux(env *config.Env, endpoint string, opts []grpc.DialOption) *http.ServeMux {
+	gwmux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(headerMatcher))
+	if err := v1.RegisterINFRAAPPLYHandlerFromEndpoint(context.Background(), gwmux, endpoint, opts); err != nil {
+		logger.Fatalf("failed to start HTTP server: %v", err)
+	}
+	if err := v1.RegisterDEVICEHandlerFromEndpoint(context.Background(), gwmux, endpoint, opts); err != nil {
+		logger.Fatalf("failed to start HTTP server: %v", err)
+	}
+	if err := v1.RegisterSUBJECTHandlerFromEndpoint(context.Background(), gwmux, endpoint, opts); err != nil {
+		logger.Fatalf("failed to start HTTP server: %v", err)
+	}
+	if err := v2.RegisterINFRAAPPLYHandlerFromEndpoint(context.Background(), gwmux, endpoint, opts); err != nil {
+		logger.Fatalf("failed to start HTTP server: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/export/applies", service.NewExportHandler(env))
+	mux.Handle("/openapi.json", docs.SpecHandler())
+	mux.Handle("/docs", docs.UIHandler("/openapi.json"))
+	mux.Handle("/", gwmux)
+	return mux
+}
+
+// serveHTTP serves handler on a tcp or unix address, with tls when store is not nil and
+// with cleartext http/2 otherwise so grpc clients can use it too
+func serveHTTP(addr string, handler http.Handler, store *certs.Store, clientAuth string) error {
+	listener, err := util.Listen(addr)
+	if err != nil {
+		return err
+	}
+
+	if store == nil {
+		srv := &http.Server{Handler: h2c.NewHandler(handler, &http2.Server{})}
+		return srv.Serve(listener)
+	}
+
+	tlsConf, err := store.ServerConfig(clientAuth)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{Handler: handler, TLSConfig: tlsConf}
+	// the certificate comes from tlsConf.GetCertificate
+	return srv.ServeTLS(listener, "", "")
+}
+
+// headerMatcher forwards the idempotency key, read primary and cache bypass headers to grpc along
+// with the default ones
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "Idempotency-Key") {
+		return "idempotency-key", true
+	}
+	if strings.EqualFold(key, "Read-Primary") {
+		return "read-primary", true
+	}
+	if strings.EqualFold(key, "Cache-Bypass") {
+		return "cache-bypass", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
 }
@@ -1,10 +1,30 @@
 package common
 
+import "time"
+
 // common define
 const (
 	CONF_PATH = "./apiserver.yaml"
 )
 
+//default listener addresses
+const (
+	GRPC_ADDRESS  = ":5000"
+	HTTP_ADDRESS  = ":8080"
+	PPROF_ADDRESS = "127.0.0.1:6060"
+)
+
+//on-demand cpu and trace captures
+const (
+	CAPTURE_SECONDS     = 30
+	CAPTURE_MAX_SECONDS = 300
+)
+
+//tenant of the callers without one
+const (
+	TENANT_DEFAULT = "default"
+)
+
 //return status
 const (
 	RESP_SUCCESS = "success"
@@ -15,3 +35,130 @@ const (
 const (
 	PAGE_SIZE = 1024
 )
+
+//page size of list requests without one
+const (
+	DEFAULT_PAGE_SIZE = 20
+)
+
+//max rows of one import
+const (
+	IMPORT_ROWS = 10000
+)
+
+//apply status
+const (
+	STATUS_INIT     = "init"
+	STATUS_REFUSED  = "refused"
+	STATUS_APPROVED = "approved"
+	STATUS_EXPIRED  = "expired"
+)
+
+//device state
+const (
+	DEVICE_ACTIVE         = "active"
+	DEVICE_MAINTENANCE    = "maintenance"
+	DEVICE_DECOMMISSIONED = "decommissioned"
+)
+
+//subject risk level
+const (
+	RISK_LOW      = "low"
+	RISK_MEDIUM   = "medium"
+	RISK_HIGH     = "high"
+	RISK_CRITICAL = "critical"
+)
+
+//workflow reviewers resolved to the owners of the device or subject of the apply
+const (
+	REVIEWER_DEVICE_OWNER  = "$device_owner"
+	REVIEWER_SUBJECT_OWNER = "$subject_owner"
+)
+
+//policy actions
+const (
+	POLICY_APPROVE  = "approve"
+	POLICY_REFUSE   = "refuse"
+	POLICY_WORKFLOW = "workflow"
+
+	POLICY_REVIEWER_PREFIX = "policy:" // review id of applies decided by a policy rule
+)
+
+//audit actions
+const (
+	AUDIT_IMPORT_CREATE = "import.create"
+	AUDIT_IMPORT_UPDATE = "import.update"
+	AUDIT_EXTEND        = "apply.extend"
+	AUDIT_REVIEW        = "apply.review"
+)
+
+//idempotency
+const (
+	IDEMPOTENCY_TTL     = 24 * time.Hour
+	IDEMPOTENCY_KEY_LEN = 128
+)
+
+//notification channels
+const (
+	NOTIFY_SMTP    = "smtp"
+	NOTIFY_WEBHOOK = "webhook"
+	NOTIFY_LOG     = "log"
+)
+
+//notification status
+const (
+	NOTIFY_SENDING = "sending"
+	NOTIFY_SENT    = "sent"
+)
+
+//tls client auth modes
+const (
+	TLS_AUTH_NONE    = "none"
+	TLS_AUTH_REQUEST = "request"
+	TLS_AUTH_VERIFY  = "verify"
+	TLS_AUTH_REQUIRE = "require"
+)
+
+//tls defaults
+const (
+	TLS_RELOAD_INTERVAL = 10 * time.Second
+)
+
+//log hooks
+const (
+	LOG_HOOK_SYSLOG  = "syslog"
+	LOG_HOOK_WEBHOOK = "webhook"
+)
+
+//expiry reminder defaults
+const (
+	REMINDER_INTERVAL = time.Minute
+)
+
+var REMINDER_WINDOWS = []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour}
+
+//apply stats defaults
+const (
+	STATS_INTERVAL   = 5 * time.Minute
+	STATS_GROUPS     = 100
+	STATS_MAX_GROUPS = PAGE_SIZE
+)
+
+//transaction defaults
+const (
+	TX_RETRIES = 3
+	TX_BACKOFF = 20 * time.Millisecond
+)
+
+//replica defaults
+const (
+	REPLICA_CHECK   = 5 * time.Second
+	REPLICA_MAX_LAG = 5 * time.Second
+)
+
+//read cache defaults
+const (
+	CACHE_SIZE       = 10000
+	CACHE_DETAIL_TTL = 30 * time.Second
+	CACHE_LIST_TTL   = 5 * time.Second
+)
@@ -0,0 +1,227 @@
+package common
+
+import (
+	"errors"
+	"strings"
+
+	"big-infra/pkg/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Row is the pointer of a registered model type T, it lets Find[model.Device] make its rows
+type Row[T any] interface {
+	*T
+	model.Model
+}
+
+type cond struct {
+	query interface{}
+	args  []interface{}
+}
+
+// Query builds the conditions, the order and the page of Find, FindOne, Update and Delete.
+// The conditions are ANDed, a nil or empty Query matches every row.
+type Query struct {
+	conds         []cond
+	orders        []string
+	limit, offset int32
+	paged         bool
+	forUpdate     bool
+}
+
+// NewQuery returns an empty Query
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// Where adds a sql condition like "name = ?" with its arguments
+func (q *Query) Where(expr string, args ...interface{}) *Query {
+	q.conds = append(q.conds, cond{query: expr, args: args})
+	return q
+}
+
+// Eq adds column = value
+func (q *Query) Eq(column string, value interface{}) *Query {
+	return q.Where(column+" = ?", value)
+}
+
+// In adds column IN (values), values is a slice
+func (q *Query) In(column string, values interface{}) *Query {
+	return q.Where(column+" IN (?)", values)
+}
+
+// Match adds column = value for every entry of fields, an empty fields adds nothing
+func (q *Query) Match(fields map[string]interface{}) *Query {
+	if len(fields) > 0 {
+		q.conds = append(q.conds, cond{query: fields})
+	}
+	return q
+}
+
+// Like adds column LIKE %s%, column may be an expression like CONCAT(code, ' ', name). The
+// wildcards of s match themselves.
+func (q *Query) Like(column, s string) *Query {
+	return q.Where(LikeContains(column, s))
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern with the ESCAPE of LikeContains
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// LikeContains returns the condition and its argument matching the rows whose column contains s,
+// % and _ in s are not wildcards
+func LikeContains(column, s string) (string, interface{}) {
+	return column + " LIKE ? ESCAPE '!'", "%" + likeEscaper.Replace(s) + "%"
+}
+
+// Order adds an order like "id DESC"
+func (q *Query) Order(order string) *Query {
+	q.orders = append(q.orders, order)
+	return q
+}
+
+// Page limits the rows of Find, a limit of -1 returns all of them
+func (q *Query) Page(limit, offset int32) *Query {
+	q.limit, q.offset, q.paged = limit, offset, true
+	return q
+}
+
+// ForUpdate makes Find and FindOne lock the rows they read until the end of the transaction, for
+// the state transitions reading a row before writing it. They fail with ErrNoTx outside of one.
+func (q *Query) ForUpdate() *Query {
+	q.forUpdate = true
+	return q
+}
+
+func (q *Query) where(db *gorm.DB) *gorm.DB {
+	if q == nil {
+		return db
+	}
+	for _, c := range q.conds {
+		db = db.Where(c.query, c.args...)
+	}
+	return db
+}
+
+func (q *Query) order(db *gorm.DB) *gorm.DB {
+	if q == nil {
+		return db
+	}
+	for _, o := range q.orders {
+		db = db.Order(o)
+	}
+	return db
+}
+
+func (q *Query) checkPage() error {
+	if q == nil || !q.paged {
+		return nil
+	}
+	if q.limit < -1 || q.limit == 0 || q.limit > PAGE_SIZE {
+		return errors.New("invalid page size")
+	}
+	if q.offset < -1 {
+		return errors.New("offset cannot be negative")
+	}
+	return nil
+}
+
+// lock makes db lock the rows read for q
+func (q *Query) lock(db *gorm.DB) (*gorm.DB, error) {
+	if q == nil || !q.forUpdate {
+		return db, nil
+	}
+	if !inTx(db) {
+		return nil, ErrNoTx
+	}
+	return ForUpdate(db), nil
+}
+
+func (q *Query) empty() bool {
+	return q == nil || len(q.conds) == 0
+}
+
+// repoTable checks db is scoped and returns the table of T
+func repoTable[T any, PT Row[T]](db *gorm.DB) (string, error) {
+	if err := checkScoped(db); err != nil {
+		return "", err
+	}
+	return tableOf(PT(new(T)))
+}
+
+// Find returns the page of rows of T matching q and how many rows match q in all
+func Find[T any, PT Row[T]](db *gorm.DB, q *Query) ([]T, int, error) {
+	table, err := repoTable[T, PT](db)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := q.checkPage(); err != nil {
+		return nil, 0, err
+	}
+	if db, err = q.lock(db); err != nil {
+		return nil, 0, err
+	}
+
+	matched := q.where(db.Table(table))
+	var total int
+	if err := matched.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	list := q.order(matched)
+	if q != nil && q.paged {
+		list = list.Limit(q.limit).Offset(q.offset)
+	}
+	var res []T
+	if err := list.Find(&res).Error; err != nil {
+		return nil, 0, err
+	}
+	return res, total, nil
+}
+
+// FindOne returns the first row of T matching q, or nil if there is none
+func FindOne[T any, PT Row[T]](db *gorm.DB, q *Query) (*T, error) {
+	table, err := repoTable[T, PT](db)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = q.lock(db); err != nil {
+		return nil, err
+	}
+
+	var res []T
+	if err := q.order(q.where(db.Table(table))).Limit(1).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return &res[0], nil
+}
+
+// Update writes fields to the rows of T matching q and returns how many were changed,
+// q must have a condition
+func Update[T any, PT Row[T]](db *gorm.DB, q *Query, fields map[string]interface{}) (int64, error) {
+	if _, err := repoTable[T, PT](db); err != nil {
+		return 0, err
+	}
+	if q.empty() {
+		return 0, errors.New("update without condition")
+	}
+
+	res := q.where(db.Model(PT(new(T)))).Updates(fields)
+	return res.RowsAffected, res.Error
+}
+
+// Delete deletes the rows of T matching q and returns how many were deleted, q must have a condition
+func Delete[T any, PT Row[T]](db *gorm.DB, q *Query) (int64, error) {
+	if _, err := repoTable[T, PT](db); err != nil {
+		return 0, err
+	}
+	if q.empty() {
+		return 0, errors.New("delete without condition")
+	}
+
+	res := q.where(db).Delete(PT(new(T)))
+	return res.RowsAffected, res.Error
+}
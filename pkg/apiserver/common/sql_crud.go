@@ -1,27 +1,29 @@
 package common
 
 import (
+	"context"
 	"errors"
-	"reflect"
 
 	"big-infra/pkg/model"
 
 	"github.com/jinzhu/gorm"
 )
 
-func getTableName(record interface{}) (string, error) {
-	var tableName string
-	switch aRecord := record.(type) {
-	default:
-		return "", errors.New("Unrecognized db model")
-	case *model.InfraApply:
-		tableName = aRecord.TableName()
+// tableOf returns the table of a model registered with model.Register
+func tableOf(record interface{}) (string, error) {
+	t, err := model.TableOf(record)
+	if err != nil {
+		return "", err
 	}
-	return tableName, nil
+	return t.Name, nil
 }
 
 func AddOne(MysqlCli *gorm.DB, aRecord interface{}) error {
-	tableName, err := getTableName(aRecord)
+	if err := checkScoped(MysqlCli); err != nil {
+		return err
+	}
+
+	tableName, err := tableOf(aRecord)
 	if err != nil {
 		return err
 	}
@@ -32,111 +34,67 @@ func AddOne(MysqlCli *gorm.DB, aRecord interface{}) error {
 }
 
 // DeleteOne deletes a single record matching the keys provided. Returns error if multiple records found.
+// Like the other helpers here it needs a db scoped by WithTenant or AllTenants. The matching rows
+// are locked and deleted in one transaction, the one of MysqlCli if it is in one.
 func DeleteOne(MysqlCli *gorm.DB, aRecord interface{}) error {
-	tableName, err := getTableName(aRecord)
-	if err != nil {
+	if err := checkScoped(MysqlCli); err != nil {
 		return err
 	}
 
-	var cnt int
-	record := reflect.ValueOf(aRecord).Interface()
-	var db = MysqlCli.Table(tableName)
-	db.Where(aRecord).Find(record).Count(&cnt)
-
-	if cnt > 1 {
-		return errors.New("multiple records found for the keywords provided")
-	}
-	return db.Delete(record).Error
-}
-
-func UpdateOne(MysqlCli *gorm.DB, aRecord interface{}, changedFields map[string]interface{}) error {
-	tableName, err := getTableName(aRecord)
+	tableName, err := tableOf(aRecord)
 	if err != nil {
 		return err
 	}
 
-	db := MysqlCli
-	record := reflect.ValueOf(aRecord).Interface()
-	var cnt int
-	db.Table(tableName).Where(aRecord).Find(record).Count(&cnt)
-
-	if cnt > 1 {
-		return errors.New("multiple records found for the keywords provided")
-	}
-	if cnt == 0 {
-		return errors.New("no record found for the keywords provided")
-	}
-
-	return db.Model(record).Updates(changedFields).Error
+	return WithTx(context.Background(), MysqlCli, TxOptions{}, func(_ context.Context, tx *gorm.DB) error {
+		cnt, err := lockRecord(tx.Table(tableName), aRecord)
+		if err != nil {
+			return err
+		}
+		if cnt > 1 {
+			return errors.New("multiple records found for the keywords provided")
+		}
+		return tx.Table(tableName).Delete(aRecord).Error
+	})
 }
 
-func Find(MysqlCli *gorm.DB, dummyRecord interface{}, query map[string]interface{},
-	limit, offset int32) (interface{}, int, error) {
-	if limit < -1 || limit == 0 || limit > PAGE_SIZE {
-		return nil, 0, errors.New("invalid page size")
-	}
-	if offset < -1 {
-		return nil, 0, errors.New("offset cannot be negative")
+// UpdateOne writes changedFields to the single record matching the keys provided, it is read
+// and updated in one transaction like in DeleteOne
+func UpdateOne(MysqlCli *gorm.DB, aRecord interface{}, changedFields map[string]interface{}) error {
+	if err := checkScoped(MysqlCli); err != nil {
+		return err
 	}
 
-	tableName, err := getTableName(dummyRecord)
+	tableName, err := tableOf(aRecord)
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
 
-	var db = MysqlCli.Table(tableName)
-	modelType := reflect.Indirect(reflect.ValueOf(dummyRecord)).Type()
-	records := reflect.New(reflect.SliceOf(modelType))
-	err = db.Where(query).Limit(limit).Offset(offset).Find(records.Interface()).Error
-	if err != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			err = nil
-		} else {
-			return nil, 0, err
+	return WithTx(context.Background(), MysqlCli, TxOptions{}, func(_ context.Context, tx *gorm.DB) error {
+		cnt, err := lockRecord(tx.Table(tableName), aRecord)
+		if err != nil {
+			return err
 		}
-
-	}
-	var total int
-	err = db.Where(query).Count(&total).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	return reflect.Indirect(records).Interface(), total, nil
+		if cnt > 1 {
+			return errors.New("multiple records found for the keywords provided")
+		}
+		if cnt == 0 {
+			return errors.New("no record found for the keywords provided")
+		}
+		return tx.Model(aRecord).Updates(changedFields).Error
+	})
 }
 
-// FindLike support `LIKE` in query with many fields, and the relation is `AND`
-func FindLike(MysqlCli *gorm.DB, dummyRecord interface{}, query map[string]interface{},
-	search map[string]interface{}, limit, offset int32) (interface{}, int, error) {
-	if limit < -1 || limit == 0 || limit > PAGE_SIZE {
-		return nil, 0, errors.New("invalid page size")
-	}
-	if offset < -1 {
-		return nil, 0, errors.New("offset cannot be negative")
-	}
-
-	tableName, err := getTableName(dummyRecord)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	var db = MysqlCli.Table(tableName)
-	modelType := reflect.Indirect(reflect.ValueOf(dummyRecord)).Type()
-	records := reflect.New(reflect.SliceOf(modelType))
-
-	Q := db.Where(query)
-	for k, v := range search {
-		Q = Q.Where(k, v)
-	}
-	err = Q.Limit(limit).Offset(offset).Find(records.Interface()).Error
-	if err != nil {
-		return nil, 0, err
+// lockRecord locks the rows matching the keys of aRecord, loads the first one into it and
+// returns how many there are
+func lockRecord(db *gorm.DB, aRecord interface{}) (int, error) {
+	var cnt int
+	matched := ForUpdate(db).Where(aRecord)
+	if err := matched.Count(&cnt).Error; err != nil {
+		return 0, err
 	}
-	var total int
-	err = Q.Count(&total).Error
-	if err != nil {
-		return nil, 0, err
+	if cnt == 0 {
+		return 0, nil
 	}
-
-	return reflect.Indirect(records).Interface(), total, nil
+	return cnt, matched.Find(aRecord).Error
 }
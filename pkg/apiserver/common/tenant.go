@@ -0,0 +1,81 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"big-infra/pkg/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	_tenantKey  = "big-infra:tenant_id"
+	_allTenants = "\x00all"
+)
+
+// ErrNoTenant is returned by the helpers of this package for a db that is not scoped
+var ErrNoTenant = errors.New("db is not scoped to a tenant")
+
+// WithTenant scopes db to tenant, every query made with it only sees the rows of tenant
+// and the rows it creates belong to tenant
+func WithTenant(db *gorm.DB, tenant string) *gorm.DB {
+	return db.Set(_tenantKey, tenant)
+}
+
+// AllTenants lets db see the rows of every tenant, for the jobs working across them
+func AllTenants(db *gorm.DB) *gorm.DB {
+	return db.Set(_tenantKey, _allTenants)
+}
+
+// TenantOf returns the tenant db is scoped to, ok is false for an unscoped db or one of AllTenants
+func TenantOf(db *gorm.DB) (tenant string, ok bool) {
+	v, set := db.Get(_tenantKey)
+	if !set || v == _allTenants {
+		return "", false
+	}
+	return v.(string), true
+}
+
+// checkScoped fails for a db made neither by WithTenant nor by AllTenants
+func checkScoped(db *gorm.DB) error {
+	if _, set := db.Get(_tenantKey); !set {
+		return ErrNoTenant
+	}
+	return nil
+}
+
+// RegisterTenantCallbacks makes the dbs scoped by WithTenant filter their queries, updates and
+// deletes on tenant_id, and set it on the rows they create
+func RegisterTenantCallbacks(db *gorm.DB) {
+	db.Callback().Query().Before("gorm:query").Register("tenant:query", scopeTenant)
+	db.Callback().RowQuery().Before("gorm:row_query").Register("tenant:row_query", scopeTenant)
+	db.Callback().Update().Before("gorm:update").Register("tenant:update", scopeTenant)
+	db.Callback().Delete().Before("gorm:delete").Register("tenant:delete", scopeTenant)
+	db.Callback().Create().Before("gorm:create").Register("tenant:create", setTenant)
+}
+
+func scopedTenant(scope *gorm.Scope) (string, bool) {
+	v, ok := scope.Get(_tenantKey)
+	if !ok || v == _allTenants {
+		return "", false
+	}
+	if t := model.LookupTable(scope.TableName()); t == nil || !t.Tenant {
+		return "", false
+	}
+	return v.(string), true
+}
+
+func scopeTenant(scope *gorm.Scope) {
+	if tenant, ok := scopedTenant(scope); ok {
+		scope.Search.Where(fmt.Sprintf("%s.tenant_id = ?", scope.QuotedTableName()), tenant)
+	}
+}
+
+func setTenant(scope *gorm.Scope) {
+	if tenant, ok := scopedTenant(scope); ok {
+		if field, found := scope.FieldByName("TenantID"); found {
+			scope.Err(field.Set(tenant))
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package common
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+)
+
+// mysql errors a transaction is run again on, InnoDB reports a serialization failure of a
+// SERIALIZABLE transaction as one of them too
+const (
+	errLockDeadlock    = 1213
+	errLockWaitTimeout = 1205
+)
+
+const (
+	queryOption    = "gorm:query_option"
+	afterCommitKey = "tx:after_commit"
+)
+
+// ErrNoTx is returned by the helpers locking rows outside of a transaction, where the lock would
+// be released at once
+var ErrNoTx = errors.New("locking rows needs a transaction")
+
+// TxOptions tunes the transactions of WithTx
+type TxOptions struct {
+	Isolation sql.IsolationLevel // sql.LevelDefault keeps the one of the server
+	Retries   int                // runs fn again up to this many times after a deadlock or a serialization failure
+	Backoff   time.Duration      // wait before the first retry, doubled for every next one
+}
+
+// ParseIsolation reads an isolation level like READ-COMMITTED or "repeatable read", empty is
+// sql.LevelDefault
+func ParseIsolation(s string) (sql.IsolationLevel, error) {
+	switch strings.NewReplacer("-", " ", "_", " ").Replace(strings.ToUpper(strings.TrimSpace(s))) {
+	case "":
+		return sql.LevelDefault, nil
+	case "READ UNCOMMITTED":
+		return sql.LevelReadUncommitted, nil
+	case "READ COMMITTED":
+		return sql.LevelReadCommitted, nil
+	case "REPEATABLE READ":
+		return sql.LevelRepeatableRead, nil
+	case "SERIALIZABLE":
+		return sql.LevelSerializable, nil
+	default:
+		return sql.LevelDefault, fmt.Errorf("unknown isolation level %q", s)
+	}
+}
+
+type txKey struct{}
+
+// TxFrom returns the transaction ctx carries, nil outside of WithTx
+func TxFrom(ctx context.Context) *gorm.DB {
+	tx, _ := ctx.Value(txKey{}).(*gorm.DB)
+	return tx
+}
+
+// inTx reports whether db runs its statements in a transaction
+func inTx(db *gorm.DB) bool {
+	_, ok := db.CommonDB().(*sql.Tx)
+	return ok
+}
+
+// WithTx runs fn in a transaction begun on db, it commits if fn returns nil and rolls back
+// otherwise. The ctx given to fn carries the transaction, a WithTx called with it or with a db
+// already in a transaction joins that one: it neither begins nor commits, and its options are
+// not applied. The transaction keeps the tenant scope of db.
+//
+// A transaction failing on a deadlock or a serialization failure is rolled back and fn is run
+// again, up to opts.Retries times, so fn must not have effects out of the database.
+func WithTx(ctx context.Context, db *gorm.DB, opts TxOptions, fn func(ctx context.Context, tx *gorm.DB) error) error {
+	if tx := TxFrom(ctx); tx != nil {
+		return fn(ctx, tx)
+	}
+	if inTx(db) {
+		return fn(context.WithValue(ctx, txKey{}, db), db)
+	}
+
+	backoff := opts.Backoff
+	for attempt := 0; ; attempt++ {
+		err := runTx(ctx, db, opts, fn)
+		if err == nil || attempt >= opts.Retries || !IsRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// runTx runs fn once in a new transaction
+func runTx(ctx context.Context, db *gorm.DB, opts TxOptions, fn func(ctx context.Context, tx *gorm.DB) error) (err error) {
+	tx := db.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	var hooks []func()
+	tx = tx.Set(afterCommitKey, &hooks)
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx), tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	for _, f := range hooks {
+		f()
+	}
+	return nil
+}
+
+// AfterCommit runs f once the transaction of WithTx db belongs to commits, f is dropped if it
+// rolls back. Out of a transaction f runs at once, the statements of db are committed already.
+func AfterCommit(db *gorm.DB, f func()) {
+	if v, ok := db.Get(afterCommitKey); ok {
+		hooks := v.(*[]func())
+		*hooks = append(*hooks, f)
+		return
+	}
+	f()
+}
+
+// IsRetryable reports whether err is a deadlock or a lock wait timeout, after which the whole
+// transaction can be run again
+func IsRetryable(err error) bool {
+	var me *mysql.MySQLError
+	if !errors.As(err, &me) {
+		return false
+	}
+	return me.Number == errLockDeadlock || me.Number == errLockWaitTimeout
+}
+
+// ForUpdate makes the queries of db lock the rows they read until the end of the transaction
+func ForUpdate(db *gorm.DB) *gorm.DB {
+	return db.Set(queryOption, "FOR UPDATE")
+}
@@ -1,29 +1,62 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/url"
 	"os"
-	"path"
-	"runtime/pprof"
+	"strings"
 	"time"
 
-	_ "github.com/go-sql-driver/mysql"
+	"big-infra/pkg/apiserver/cache"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/replica"
+	"big-infra/pkg/apiserver/util"
+
+	"github.com/go-sql-driver/mysql"
 	"github.com/jinzhu/gorm"
 	logger "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
 
 type IdentifyCfg struct {
-	AuthSecret string `yaml:"AuthSecret"`
+	AuthSecret string   `yaml:"AuthSecret"`
+	Admins     []string `yaml:"Admins"` // uids allowed to use the admin endpoints
+}
+
+type LogHookCfg struct {
+	Type    string            `yaml:"Type"`    // syslog|webhook
+	Level   string            `yaml:"Level"`   // send entries of this level and above, default error
+	Network string            `yaml:"Network"` // syslog network like udp, empty with Addr for the local socket
+	Addr    string            `yaml:"Addr"`    // syslog server host:port
+	Tag     string            `yaml:"Tag"`     // syslog tag, default the ProjectName
+	URL     string            `yaml:"URL"`     // webhook url, the entries are posted as json
+	Headers map[string]string `yaml:"Headers"`
+	Timeout time.Duration     `yaml:"Timeout"` // webhook timeout, default 10s
+}
+
+type RequestLogCfg struct {
+	RedactFields  []string `yaml:"RedactFields"`  // proto field names logged as [REDACTED] at any depth, or /pkg.SERVICE/Method:name for one method, token, password, secret and authorization always are
+	MaxFieldLen   int      `yaml:"MaxFieldLen"`   // longer strings are truncated, default 256
+	NoArgsMethods []string `yaml:"NoArgsMethods"` // full grpc methods like /InfraApply.INFRAAPPLY/AddInfraApply logged without their arguments
 }
 
 type LogCfg struct {
-	LogPath   string `yaml:"LogPath"`
-	LogLevel  string `yaml:"LogLevel"`
-	IsStdOut  bool   `yaml:"IsStdOut"`
-	IsPProf   bool   `yaml:"IsPProf"`
-	PathPProf string `yaml:"PathPProf"`
+	LogPath      string        `yaml:"LogPath"` // log file, rotated, stderr if empty
+	LogLevel     string        `yaml:"LogLevel"`
+	IsStdOut     bool          `yaml:"IsStdOut"`    // log as text to stdout, besides LogPath if set
+	MaxSizeMB    int           `yaml:"MaxSizeMB"`   // rotate LogPath at this size, default 100
+	MaxAgeDays   int           `yaml:"MaxAgeDays"`  // remove rotated files older than this, 0 keeps them
+	MaxBackups   int           `yaml:"MaxBackups"`  // keep at most this many rotated files, 0 keeps them all
+	Compress     bool          `yaml:"Compress"`    // gzip the rotated files
+	RotateEvery  time.Duration `yaml:"RotateEvery"` // also rotate on this period, like 24h, 0 rotates on size only
+	Hooks        []LogHookCfg  `yaml:"Hooks"`
+	Request      RequestLogCfg `yaml:"Request"`      // arguments of the grpc request log
+	IsPProf      bool          `yaml:"IsPProf"`      // serve the admin only diagnostics listener
+	PProfAddress string        `yaml:"PProfAddress"` // host:port or unix:/path/to.sock of the diagnostics listener, default 127.0.0.1:6060
 }
 
 type MySQLCfg struct {
@@ -31,63 +64,319 @@ type MySQLCfg struct {
 	Active      int           `yaml:"Active"`
 	Idle        int           `yaml:"Idle"`
 	IdleTimeout time.Duration `yaml:"IdleTimeout"`
+	TxIsolation string        `yaml:"TxIsolation"` // READ-COMMITTED, REPEATABLE-READ or SERIALIZABLE, empty keeps the one of the server
+	TxRetries   int           `yaml:"TxRetries"`   // runs of a transaction again after a deadlock, default 3, -1 never
+	TxBackoff   time.Duration `yaml:"TxBackoff"`   // wait before the first run again, doubled for the next, default 20ms
+
+	Replicas      []ReplicaCfg  `yaml:"Replicas"`      // read only replicas, the reads not pinned to the primary go to them
+	ReplicaCheck  time.Duration `yaml:"ReplicaCheck"`  // period of the health and lag checks of the replicas, default 5s
+	MaxReplicaLag time.Duration `yaml:"MaxReplicaLag"` // replicas further behind are not read, and callers read the primary this long after their writes, default 5s
+}
+
+type ReplicaCfg struct {
+	DSN    string `yaml:"DSN"`
+	Weight int    `yaml:"Weight"` // share of the reads against the other replicas, default 1
+}
+
+// TxOptions returns the options of the transactions of the service
+func (c *MySQLCfg) TxOptions() (common.TxOptions, error) {
+	isolation, err := common.ParseIsolation(c.TxIsolation)
+	if err != nil {
+		return common.TxOptions{}, err
+	}
+
+	opts := common.TxOptions{Isolation: isolation, Retries: c.TxRetries, Backoff: c.TxBackoff}
+	if opts.Retries == 0 {
+		opts.Retries = common.TX_RETRIES
+	}
+	if opts.Retries < 0 {
+		opts.Retries = 0
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = common.TX_BACKOFF
+	}
+	return opts, nil
 }
 
 type GrpcSrvCfg struct {
-	Address string `yaml:"Address"`
+	Address    string   `yaml:"Address"`    // host:port or unix:/path/to.sock, default :5000
+	SinglePort bool     `yaml:"SinglePort"` // serve grpc, grpc-web and the http gateway all on Address, HttpSrv is not used
+	WebOrigins []string `yaml:"WebOrigins"` // origins allowed to call grpc-web cross origin in SinglePort mode, * for any
+}
+
+type HttpSrvCfg struct {
+	Address string `yaml:"Address"` // host:port or unix:/path/to.sock, default :8080
+}
+
+type ServiceAccountCfg struct {
+	Subject   string `yaml:"Subject"`   // client certificate subject, the common name or the full DN like "CN=deployer,O=ops"
+	Principal string `yaml:"Principal"` // uid the requests of the certificate run as
+	Tenant    string `yaml:"Tenant"`    // tenant of the requests, default Tenancy.Default
+}
+
+type TLSCfg struct {
+	Enabled         bool                `yaml:"Enabled"`
+	CertFile        string              `yaml:"CertFile"` // server certificate of both listeners
+	KeyFile         string              `yaml:"KeyFile"`
+	ClientCAFile    string              `yaml:"ClientCAFile"`   // CA of the client certificates
+	ClientAuth      string              `yaml:"ClientAuth"`     // grpc listener: none|request|verify|require, default verify with a ClientCAFile else none
+	HTTPClientAuth  string              `yaml:"HTTPClientAuth"` // gateway listener, same values, default none
+	MinVersion      string              `yaml:"MinVersion"`     // 1.2|1.3, default 1.2
+	CAFile          string              `yaml:"CAFile"`         // CA the gateway verifies the grpc server with, default ClientCAFile
+	ServerName      string              `yaml:"ServerName"`     // name in the grpc server certificate, default the dial host
+	ClientCertFile  string              `yaml:"ClientCertFile"` // certificate the gateway dials grpc with, default CertFile
+	ClientKeyFile   string              `yaml:"ClientKeyFile"`
+	ReloadInterval  time.Duration       `yaml:"ReloadInterval"` // how often the files are checked for changes, default 10s
+	ServiceAccounts []ServiceAccountCfg `yaml:"ServiceAccounts"`
+}
+
+type TimeCfg struct {
+	Timezone   string `yaml:"Timezone"`   // IANA name of the server timezone string times are read and written in, default the system one
+	DBTimezone string `yaml:"DBTimezone"` // timezone of the DATETIME columns, default Timezone, ignored if the DSN sets loc
+}
+
+type IdempotencyCfg struct {
+	TTL time.Duration `yaml:"TTL"` // how long a stored response can be replayed, default 24h
+}
+
+type DeviceCfg struct {
+	AllowUnknown bool `yaml:"AllowUnknown"` // accept applies for devices missing from the inventory, while it is filled
+}
+
+type StatsCfg struct {
+	Enabled  bool          `yaml:"Enabled"`  // refresh the summary of GetInfraApplyStats, one replica is enough
+	Interval time.Duration `yaml:"Interval"` // how often the summary is rebuilt, default 5m
+}
+
+type CacheCfg struct {
+	Enabled   bool          `yaml:"Enabled"`   // cache the replies of the hot reads in process, the writes of this process invalidate them, those of the other apiservers show after the ttl
+	Size      int           `yaml:"Size"`      // replies kept, the least recently used are dropped first, default 10000
+	DetailTTL time.Duration `yaml:"DetailTTL"` // how long the reply of a lookup by id or name is kept, default 30s
+	ListTTL   time.Duration `yaml:"ListTTL"`   // how long a list reply is kept, default 5s
+}
+
+type SearchCfg struct {
+	MinTokenLen int `yaml:"MinTokenLen"` // innodb_ft_min_token_size of the server, shorter words are ignored, default 3
+}
+
+type NotifyChannelCfg struct {
+	Type     string            `yaml:"Type"`     // smtp|webhook|log
+	Addr     string            `yaml:"Addr"`     // smtp server host:port
+	From     string            `yaml:"From"`     // smtp sender address
+	Username string            `yaml:"Username"` // smtp plain auth, no auth if empty
+	Password string            `yaml:"Password"`
+	Domain   string            `yaml:"Domain"` // smtp recipient is uid@Domain
+	URL      string            `yaml:"URL"`    // webhook url, the message is posted as json
+	Headers  map[string]string `yaml:"Headers"`
+	Timeout  time.Duration     `yaml:"Timeout"` // default 10s
+}
+
+type ReminderCfg struct {
+	Enabled  bool               `yaml:"Enabled"`
+	Interval time.Duration      `yaml:"Interval"` // how often expiring grants are checked, default 1m
+	Windows  []time.Duration    `yaml:"Windows"`  // remind this long before expiry, default 168h, 24h and 1h
+	Channels []NotifyChannelCfg `yaml:"Channels"` // default log
+}
+
+type TenantCfg struct {
+	ID              string             `yaml:"ID"`
+	Admins          []string           `yaml:"Admins"`          // uids managing the catalog and exporting the applies of the tenant, besides Identify.Admins
+	DefaultDuration time.Duration      `yaml:"DefaultDuration"` // grant length of applies without expiry, when their subject sets none
+	MaxDuration     time.Duration      `yaml:"MaxDuration"`     // longest grant of the tenant, a shorter subject maximum still applies
+	Channels        []NotifyChannelCfg `yaml:"Channels"`        // expiry reminder channels, default Reminder.Channels
+}
+
+type TenancyCfg struct {
+	Default string      `yaml:"Default"` // tenant of the callers whose token has no tenant claim, default "default"
+	Tenants []TenantCfg `yaml:"Tenants"` // when set, callers of other tenants are rejected
+}
+
+type PolicyRuleCfg struct {
+	Name     string `yaml:"Name"`
+	Expr     string `yaml:"Expr"`     // CEL expression, see policy.Rule
+	Action   string `yaml:"Action"`   // approve|refuse|workflow
+	Workflow string `yaml:"Workflow"` // workflow name for the workflow action
+	Priority int32  `yaml:"Priority"` // lower runs first, ties keep config rules before table rules
+	Tenant   string `yaml:"Tenant"`   // only for the applies of this tenant, every tenant if empty
+}
+
+type PolicyCfg struct {
+	Rules []PolicyRuleCfg `yaml:"Rules"`
 }
 
 type Config struct {
-	ProjectName string      `yaml:"ProjectName"`
-	Identify    IdentifyCfg `yaml:"Identify"`
-	Log         LogCfg      `yaml:"Log"`
-	MySQL       MySQLCfg    `yaml:"MySQL"`
-	GrpcSrv     GrpcSrvCfg  `yaml:"GrpcSrv"`
+	ProjectName string         `yaml:"ProjectName"`
+	Identify    IdentifyCfg    `yaml:"Identify"`
+	Log         LogCfg         `yaml:"Log"`
+	MySQL       MySQLCfg       `yaml:"MySQL"`
+	GrpcSrv     GrpcSrvCfg     `yaml:"GrpcSrv"`
+	HttpSrv     HttpSrvCfg     `yaml:"HttpSrv"`
+	TLS         TLSCfg         `yaml:"TLS"`
+	Time        TimeCfg        `yaml:"Time"`
+	Idempotency IdempotencyCfg `yaml:"Idempotency"`
+	Policy      PolicyCfg      `yaml:"Policy"`
+	Device      DeviceCfg      `yaml:"Device"`
+	Reminder    ReminderCfg    `yaml:"Reminder"`
+	Tenancy     TenancyCfg     `yaml:"Tenancy"`
+	Search      SearchCfg      `yaml:"Search"`
+	Stats       StatsCfg       `yaml:"Stats"`
+	Cache       CacheCfg       `yaml:"Cache"`
+}
+
+// Tenant returns the config of tenant, nil if it has none
+func (c *Config) Tenant(tenant string) *TenantCfg {
+	for i := range c.Tenancy.Tenants {
+		if c.Tenancy.Tenants[i].ID == tenant {
+			return &c.Tenancy.Tenants[i]
+		}
+	}
+	return nil
 }
 
 type Env struct {
 	Cfg      *Config
 	MysqlCli *gorm.DB
+	Replicas *replica.Set // routes the reads, to MysqlCli when there is no replica
+	Cache    *cache.Store // nil when the cache is disabled
 }
 
-var (
-	cpuProfilingFile,
-	memProfilingFile,
-	blockProfilingFile,
-	goroutineProfilingFile,
-	threadCreateProfilingFile *os.File
-)
-
 func InitLog(setting *Config) error {
 	logger.SetFormatter(&logger.JSONFormatter{})
-	if setting.Log.LogLevel == "" {
-		return nil
+	if setting.Log.LogLevel != "" {
+		lvl, err := logger.ParseLevel(setting.Log.LogLevel)
+		if err != nil {
+			return err
+		}
+		logger.SetLevel(lvl)
 	}
 
-	lvl, err := logger.ParseLevel(setting.Log.LogLevel)
-	if err != nil {
-		return err
+	var outputs []io.Writer
+	if setting.Log.LogPath != "" {
+		outputs = append(outputs, logging.NewFileWriter(setting.Log.LogPath, logging.RotateOptions{
+			MaxSizeMB:  setting.Log.MaxSizeMB,
+			MaxAgeDays: setting.Log.MaxAgeDays,
+			MaxBackups: setting.Log.MaxBackups,
+			Compress:   setting.Log.Compress,
+			Every:      setting.Log.RotateEvery,
+		}))
 	}
-	logger.SetLevel(lvl)
-
 	if setting.Log.IsStdOut {
-		logger.SetOutput(os.Stdout)
-		logger.SetFormatter(&logger.TextFormatter{})
+		outputs = append(outputs, os.Stdout)
+		if setting.Log.LogPath == "" {
+			// the file keeps json for the log collectors
+			logger.SetFormatter(&logger.TextFormatter{})
+		}
+	}
+	if len(outputs) > 0 {
+		logger.SetOutput(io.MultiWriter(outputs...))
 	}
 
 	logger.SetReportCaller(true)
 
-	// todo AddHook
+	for _, hookCfg := range setting.Log.Hooks {
+		hook, err := newLogHook(setting, hookCfg)
+		if err != nil {
+			return err
+		}
+		logger.AddHook(hook)
+	}
 
 	return nil
 }
 
+func newLogHook(setting *Config, cfg LogHookCfg) (logger.Hook, error) {
+	level := logger.ErrorLevel
+	if cfg.Level != "" {
+		lvl, err := logger.ParseLevel(cfg.Level)
+		if err != nil {
+			return nil, err
+		}
+		level = lvl
+	}
+
+	switch cfg.Type {
+	case common.LOG_HOOK_SYSLOG:
+		tag := cfg.Tag
+		if tag == "" {
+			tag = setting.ProjectName
+		}
+		return logging.NewSyslogHook(cfg.Network, cfg.Addr, tag, level)
+	case common.LOG_HOOK_WEBHOOK:
+		if cfg.URL == "" {
+			return nil, errors.New("log webhook hook needs a URL")
+		}
+		return logging.NewWebhookHook(cfg.URL, cfg.Headers, level, cfg.Timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown log hook type %q", cfg.Type)
+	}
+}
+
 func InitMySQLClient(setting *Config) (*gorm.DB, error) {
-	db, err := gorm.Open("mysql", setting.MySQL.DSN)
+	dbTZ := setting.Time.DBTimezone
+	if dbTZ == "" {
+		dbTZ = setting.Time.Timezone
+	}
+
+	if _, err := setting.MySQL.TxOptions(); err != nil {
+		return nil, err
+	}
+
+	return openMySQL(setting, setting.MySQL.DSN, dbTZ)
+}
+
+// InitMySQLReplicas opens the replicas of the MySQL config, their pools are sized like the one of
+// the primary
+func InitMySQLReplicas(setting *Config, primary *gorm.DB) (*replica.Set, error) {
+	dbTZ := setting.Time.DBTimezone
+	if dbTZ == "" {
+		dbTZ = setting.Time.Timezone
+	}
+
+	var members []replica.Member
+	for i, cfg := range setting.MySQL.Replicas {
+		mc, err := mysql.ParseDSN(cfg.DSN)
+		if err != nil {
+			return nil, fmt.Errorf("replica %d: %v", i, err)
+		}
+		db, err := openMySQL(setting, cfg.DSN, dbTZ)
+		if err != nil {
+			return nil, fmt.Errorf("replica %s: %v", mc.Addr, err)
+		}
+		members = append(members, replica.Member{Name: mc.Addr, DB: db, Weight: cfg.Weight})
+	}
+
+	maxLag := setting.MySQL.MaxReplicaLag
+	if maxLag <= 0 {
+		maxLag = common.REPLICA_MAX_LAG
+	}
+	return replica.New(primary, members, maxLag), nil
+}
+
+// InitCache news the cache of the hot reads invalidated by the writes of primary, nil if it is
+// disabled
+func InitCache(setting *Config, primary *gorm.DB) *cache.Store {
+	if !setting.Cache.Enabled {
+		return nil
+	}
+
+	size := setting.Cache.Size
+	if size <= 0 {
+		size = common.CACHE_SIZE
+	}
+	store := cache.NewStore(cache.NewLRU(size))
+	store.RegisterCallbacks(primary)
+	return store
+}
+
+// openMySQL opens dsn with the pool settings of the MySQL config
+func openMySQL(setting *Config, dsn, dbTZ string) (*gorm.DB, error) {
+	db, err := gorm.Open("mysql", dsnWithLocation(dsn, dbTZ))
 	if err != nil {
 		return nil, err
 	}
 
+	common.RegisterTenantCallbacks(db)
+
 	db.DB().SetMaxIdleConns(setting.MySQL.Idle)
 	db.DB().SetMaxOpenConns(setting.MySQL.Active)
 	db.DB().SetConnMaxLifetime(time.Duration(setting.MySQL.IdleTimeout) / time.Second)
@@ -99,33 +388,25 @@ func InitMySQLClient(setting *Config) (*gorm.DB, error) {
 	return db, nil
 }
 
-func InitDebugPProf(setting *Config) error {
-	if setting.Log.IsPProf {
-		pathPrefix := path.Join(setting.Log.PathPProf, fmt.Sprintf("%d", os.Getpid()))
-		logger.Infof("start pprof, and will save to %s", pathPrefix)
-		cpuProfilingFile, _ = os.Create(pathPrefix + "-cpu.prof")
-		memProfilingFile, _ = os.Create(pathPrefix + "-mem.prof")
-		blockProfilingFile, _ = os.Create(pathPrefix + "-block.prof")
-		goroutineProfilingFile, _ = os.Create(pathPrefix + "-goroutine.prof")
-		threadCreateProfilingFile, _ = os.Create(pathPrefix + "-threadcreat.prof")
-		_ = pprof.StartCPUProfile(cpuProfilingFile)
+// dsnWithLocation makes the driver read and write DATETIME columns in tz, a DSN that sets loc is kept
+func dsnWithLocation(dsn, tz string) string {
+	if tz == "" || strings.Contains(dsn, "loc=") {
+		return dsn
 	}
-	return nil
-}
 
-// SaveProfile try to save pprof into local file
-func (e *Env) SaveProfile() {
-	if e.Cfg.Log.IsPProf {
-		goroutine := pprof.Lookup("goroutine")
-		goroutine.WriteTo(goroutineProfilingFile, 1)
-		heap := pprof.Lookup("heap")
-		heap.WriteTo(memProfilingFile, 1)
-		block := pprof.Lookup("block")
-		block.WriteTo(blockProfilingFile, 1)
-		threadcreate := pprof.Lookup("threadcreate")
-		threadcreate.WriteTo(threadCreateProfilingFile, 1)
-		pprof.StopCPUProfile()
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	dsn += sep + "loc=" + url.QueryEscape(tz)
+	if !strings.Contains(dsn, "parseTime=") {
+		dsn += "&parseTime=true"
 	}
+	return dsn
+}
+
+func InitTimezone(setting *Config) error {
+	return util.SetLocation(setting.Time.Timezone)
 }
 
 func Init(confPath string) (*Env, error) {
@@ -140,12 +421,12 @@ func Init(confPath string) (*Env, error) {
 		panic(err)
 	}
 
-	err = InitDebugPProf(&setting)
+	err = InitLog(&setting)
 	if err != nil {
 		return nil, err
 	}
 
-	err = InitLog(&setting)
+	err = InitTimezone(&setting)
 	if err != nil {
 		return nil, err
 	}
@@ -155,5 +436,10 @@ func Init(confPath string) (*Env, error) {
 		return nil, err
 	}
 
-	return &Env{&setting, mysqlCli}, nil
+	replicas, err := InitMySQLReplicas(&setting, mysqlCli)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Env{&setting, mysqlCli, replicas, InitCache(&setting, mysqlCli)}, nil
 }
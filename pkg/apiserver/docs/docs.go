@@ -0,0 +1,46 @@
+// Package docs serves the OpenAPI document of the http gateway and an explorer to try it.
+//
+// spec.go is generated from the http annotations of the protos, after changing them run
+// go generate in this directory with protoc and protoc-gen-swagger in PATH.
+package docs
+
+//go:generate protoc -I../api/v1 -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis --swagger_out=. microservcice.proto
+//go:generate mv microservcice.swagger.json v1.swagger.json
+//go:generate protoc -I../api/v2 -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis --swagger_out=. microservice.proto
+//go:generate mv microservice.swagger.json v2.swagger.json
+//go:generate go run gen.go
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// _startTime is the Last-Modified of the pages, they only change with the binary
+var _startTime = time.Now()
+
+// SpecHandler serves the OpenAPI document
+func SpecHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		http.ServeContent(w, r, "openapi.json", _startTime, strings.NewReader(openAPISpec))
+	})
+}
+
+// UIHandler serves the explorer, it reads the document from specPath
+func UIHandler(specPath string) http.Handler {
+	page := strings.Replace(explorerHTML, "{{SPEC_PATH}}", specPath, -1)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", "default-src 'self'; script-src 'unsafe-inline'; style-src 'unsafe-inline'")
+		http.ServeContent(w, r, "docs.html", _startTime, strings.NewReader(page))
+	})
+}
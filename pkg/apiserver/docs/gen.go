@@ -0,0 +1,102 @@
+// +build ignore
+
+// gen merges the swagger documents of the api versions into spec.go
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+type document map[string]interface{}
+
+func load(name string) document {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	var d document
+	if err := json.Unmarshal(b, &d); err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// retag renames the tags and operation ids of d, so services of another version do not collide
+func retag(d document, suffix string) {
+	for _, item := range d["paths"].(map[string]interface{}) {
+		for _, op := range item.(map[string]interface{}) {
+			op := op.(map[string]interface{})
+			if tags, ok := op["tags"].([]interface{}); ok {
+				for i, tag := range tags {
+					tags[i] = fmt.Sprintf("%s %s", tag, suffix)
+				}
+			}
+			op["operationId"] = suffix + "_" + op["operationId"].(string)
+		}
+	}
+}
+
+// exportPath describes the export endpoint, it is a plain http handler and has no proto
+var exportPath = map[string]interface{}{
+	"get": map[string]interface{}{
+		"summary":     "Export applies as csv or xlsx, admins only",
+		"operationId": "EXPORT_ExportApplies",
+		"produces":    []string{"text/csv", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+		"parameters": []map[string]interface{}{
+			{"name": "format", "in": "query", "type": "string", "enum": []string{"csv", "xlsx"}},
+			{"name": "search", "in": "query", "type": "string"},
+			{"name": "tz", "in": "query", "type": "string", "description": "IANA timezone of the times"},
+		},
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{"description": "The applies.", "schema": map[string]string{"type": "file"}},
+			"401": map[string]interface{}{"description": "No or bad bearer token."},
+			"403": map[string]interface{}{"description": "Not an admin."},
+		},
+		"tags": []string{"EXPORT"},
+	},
+}
+
+func main() {
+	spec := load("v1.swagger.json")
+	v2 := load("v2.swagger.json")
+	retag(v2, "v2")
+
+	paths := spec["paths"].(map[string]interface{})
+	for k, v := range v2["paths"].(map[string]interface{}) {
+		paths[k] = v
+	}
+	paths["/v1/export/applies"] = exportPath
+
+	defs := spec["definitions"].(map[string]interface{})
+	for k, v := range v2["definitions"].(map[string]interface{}) {
+		defs[k] = v
+	}
+
+	spec["info"] = map[string]interface{}{
+		"title":       "big-infra apiserver",
+		"description": "HTTP gateway of the apiserver. Requests carry a bearer token in the Authorization header.",
+		"version":     "v1",
+	}
+	spec["securityDefinitions"] = map[string]interface{}{
+		"bearer": map[string]string{"type": "apiKey", "name": "Authorization", "in": "header"},
+	}
+	spec["security"] = []map[string][]string{{"bearer": {}}}
+
+	b, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	if strings.Contains(string(b), "`") {
+		panic("spec contains a backquote")
+	}
+
+	out := "// Code generated by gen.go. DO NOT EDIT.\n\npackage docs\n\nconst openAPISpec = `" + string(b) + "\n`\n"
+	if err := ioutil.WriteFile("spec.go", []byte(out), 0644); err != nil {
+		panic(err)
+	}
+	fmt.Fprintln(os.Stderr, "wrote spec.go")
+}
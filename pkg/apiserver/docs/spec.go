@@ -0,0 +1,2932 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+package docs
+
+const openAPISpec = `{
+  "consumes": [
+    "application/json"
+  ],
+  "definitions": {
+    "InfraApplyAddDeviceReply": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyAddDeviceReq": {
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "environment": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "tags": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyAddInfraApplyReply": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "policyRule": {
+          "type": "string"
+        },
+        "result": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyAddInfraApplyReq": {
+      "properties": {
+        "deviceCode": {
+          "type": "string"
+        },
+        "expireTM": {
+          "type": "string"
+        },
+        "subjectName": {
+          "type": "string"
+        },
+        "uid": {
+          "type": "string"
+        }
+      },
+      "title": "Add",
+      "type": "object"
+    },
+    "InfraApplyAddSubjectReply": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyAddSubjectReq": {
+      "properties": {
+        "defaultDurationSec": {
+          "format": "int64",
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "maxDurationSec": {
+          "format": "int64",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "owners": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "riskLevel": {
+          "type": "string"
+        },
+        "workflow": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyBatchUpdateInfraApplyReply": {
+      "properties": {
+        "failed": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "items": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyBatchUpdateItem"
+          },
+          "type": "array"
+        },
+        "result": {
+          "type": "string"
+        },
+        "succeeded": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyBatchUpdateInfraApplyReq": {
+      "properties": {
+        "IDs": {
+          "items": {
+            "format": "int32",
+            "type": "integer"
+          },
+          "type": "array"
+        },
+        "applyer": {
+          "type": "string"
+        },
+        "bestEffort": {
+          "type": "boolean"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "deviceCode": {
+          "type": "string"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "expireTM": {
+          "type": "string"
+        },
+        "extendsID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "search": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "title": "Batch update",
+      "type": "object"
+    },
+    "InfraApplyBatchUpdateItem": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "error": {
+          "type": "string"
+        },
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyDelDeviceReply": {
+      "properties": {
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyDelDeviceReq": {
+      "properties": {
+        "code": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyDelInfraApplyReply": {
+      "properties": {
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyDelInfraApplyReq": {
+      "properties": {
+        "ID": {
+          "type": "string"
+        },
+        "uid": {
+          "type": "string"
+        }
+      },
+      "title": "Delete",
+      "type": "object"
+    },
+    "InfraApplyDelSubjectReply": {
+      "properties": {
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyDelSubjectReq": {
+      "properties": {
+        "name": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyDetailInfraApplyReply": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "applyer": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "device": {
+          "$ref": "#/definitions/InfraApplyDeviceDetail"
+        },
+        "deviceCode": {
+          "type": "string"
+        },
+        "expireTM": {
+          "type": "string"
+        },
+        "extendsID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "policyRule": {
+          "type": "string"
+        },
+        "reviewId": {
+          "type": "string"
+        },
+        "reviewTM": {
+          "type": "string"
+        },
+        "stage": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "status": {
+          "type": "string"
+        },
+        "subjectName": {
+          "type": "string"
+        },
+        "workflowID": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyDeviceDetail": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "code": {
+          "type": "string"
+        },
+        "environment": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "tags": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      },
+      "title": "Device",
+      "type": "object"
+    },
+    "InfraApplyExtendInfraApplyReply": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "policyRule": {
+          "type": "string"
+        },
+        "result": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyExtendInfraApplyReq": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "expireTM": {
+          "type": "string"
+        },
+        "uid": {
+          "type": "string"
+        }
+      },
+      "title": "Extend",
+      "type": "object"
+    },
+    "InfraApplyGetDeviceReq": {
+      "properties": {
+        "code": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyGetInfraApplyReq": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "title": "Get",
+      "type": "object"
+    },
+    "InfraApplyGetInfraApplyStatsReply": {
+      "properties": {
+        "group": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyInfraApplyStatsGroup"
+          },
+          "type": "array"
+        },
+        "refreshTM": {
+          "type": "string"
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyGetInfraApplyStatsReq": {
+      "properties": {
+        "applyer": {
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "fromDay": {
+          "type": "string"
+        },
+        "groupBy": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "limit": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "reviewer": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "subjectName": {
+          "type": "string"
+        },
+        "toDay": {
+          "type": "string"
+        }
+      },
+      "title": "Stats",
+      "type": "object"
+    },
+    "InfraApplyGetSubjectReq": {
+      "properties": {
+        "name": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyImportInfraApplyReply": {
+      "properties": {
+        "batchID": {
+          "type": "string"
+        },
+        "created": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "errors": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyImportRowError"
+          },
+          "type": "array"
+        },
+        "failed": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "result": {
+          "type": "string"
+        },
+        "total": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "updated": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyImportInfraApplyReq": {
+      "properties": {
+        "dryRun": {
+          "type": "boolean"
+        },
+        "row": {
+          "$ref": "#/definitions/InfraApplyImportInfraApplyRow"
+        },
+        "upsert": {
+          "type": "boolean"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyImportInfraApplyRow": {
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "deviceCode": {
+          "type": "string"
+        },
+        "expireTM": {
+          "type": "string"
+        },
+        "line": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "reviewId": {
+          "type": "string"
+        },
+        "reviewTM": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "subjectName": {
+          "type": "string"
+        },
+        "uid": {
+          "type": "string"
+        }
+      },
+      "title": "Import",
+      "type": "object"
+    },
+    "InfraApplyImportRowError": {
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "line": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyInfraApplyPatch": {
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "expireTM": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "title": "the fields of an apply a review can change, status is always needed",
+      "type": "object"
+    },
+    "InfraApplyInfraApplyReviewDetail": {
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "decision": {
+          "type": "string"
+        },
+        "reviewTM": {
+          "type": "string"
+        },
+        "reviewer": {
+          "type": "string"
+        },
+        "stage": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyInfraApplyStatsGroup": {
+      "properties": {
+        "active": {
+          "format": "int64",
+          "type": "string"
+        },
+        "applyer": {
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "latencyP50Sec": {
+          "format": "double",
+          "type": "number"
+        },
+        "latencyP90Sec": {
+          "format": "double",
+          "type": "number"
+        },
+        "latencyP99Sec": {
+          "format": "double",
+          "type": "number"
+        },
+        "reviewed": {
+          "format": "int64",
+          "type": "string"
+        },
+        "reviewer": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "subjectName": {
+          "type": "string"
+        },
+        "total": {
+          "format": "int64",
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyListDeviceReply": {
+      "properties": {
+        "exhausted": {
+          "type": "boolean"
+        },
+        "page": {
+          "$ref": "#/definitions/InfraApplyModelPage"
+        },
+        "record": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyDeviceDetail"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyListDeviceReq": {
+      "properties": {
+        "environment": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "pageIdx": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageSize": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "search": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "tag": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyListInfraApplyReply": {
+      "properties": {
+        "exhausted": {
+          "type": "boolean"
+        },
+        "page": {
+          "$ref": "#/definitions/InfraApplyModelPage"
+        },
+        "record": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyDetailInfraApplyReply"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyListInfraApplyReq": {
+      "properties": {
+        "applyer": {
+          "type": "string"
+        },
+        "deviceCode": {
+          "type": "string"
+        },
+        "extendsID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageIdx": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageSize": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "search": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "title": "List",
+      "type": "object"
+    },
+    "InfraApplyListInfraApplyReviewReply": {
+      "properties": {
+        "record": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyInfraApplyReviewDetail"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyListInfraApplyReviewReq": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "title": "Review",
+      "type": "object"
+    },
+    "InfraApplyListSubjectReply": {
+      "properties": {
+        "exhausted": {
+          "type": "boolean"
+        },
+        "page": {
+          "$ref": "#/definitions/InfraApplyModelPage"
+        },
+        "record": {
+          "items": {
+            "$ref": "#/definitions/InfraApplySubjectDetail"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyListSubjectReq": {
+      "properties": {
+        "owner": {
+          "type": "string"
+        },
+        "pageIdx": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageSize": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "riskLevel": {
+          "type": "string"
+        },
+        "search": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyListWorkflowReply": {
+      "properties": {
+        "page": {
+          "$ref": "#/definitions/InfraApplyModelPage"
+        },
+        "record": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyWorkflowDetail"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyListWorkflowReq": {
+      "properties": {
+        "pageIdx": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageSize": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyModelPage": {
+      "properties": {
+        "pageIdx": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageSize": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "total": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "title": "page message struct",
+      "type": "object"
+    },
+    "InfraApplyPolicyRuleResult": {
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "matched": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplySaveWorkflowReply": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplySaveWorkflowReq": {
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "stages": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyWorkflowStage"
+          },
+          "type": "array"
+        },
+        "subjects": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplySearchInfraApplyHit": {
+      "properties": {
+        "apply": {
+          "$ref": "#/definitions/InfraApplyDetailInfraApplyReply"
+        },
+        "highlights": {
+          "additionalProperties": {
+            "type": "string"
+          },
+          "type": "object"
+        },
+        "score": {
+          "format": "double",
+          "type": "number"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplySearchInfraApplyReply": {
+      "properties": {
+        "exhausted": {
+          "type": "boolean"
+        },
+        "hit": {
+          "items": {
+            "$ref": "#/definitions/InfraApplySearchInfraApplyHit"
+          },
+          "type": "array"
+        },
+        "page": {
+          "$ref": "#/definitions/InfraApplyModelPage"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplySearchInfraApplyReq": {
+      "properties": {
+        "applyer": {
+          "type": "string"
+        },
+        "deviceCode": {
+          "type": "string"
+        },
+        "pageIdx": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageSize": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "query": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "title": "Search",
+      "type": "object"
+    },
+    "InfraApplySimulatePolicyReply": {
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "results": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyPolicyRuleResult"
+          },
+          "type": "array"
+        },
+        "rule": {
+          "type": "string"
+        },
+        "workflow": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplySimulatePolicyReq": {
+      "properties": {
+        "deviceCode": {
+          "type": "string"
+        },
+        "evalTM": {
+          "type": "string"
+        },
+        "expireTM": {
+          "type": "string"
+        },
+        "subjectName": {
+          "type": "string"
+        },
+        "uid": {
+          "type": "string"
+        }
+      },
+      "title": "Policy",
+      "type": "object"
+    },
+    "InfraApplySubjectDetail": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "defaultDurationSec": {
+          "format": "int64",
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "maxDurationSec": {
+          "format": "int64",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "owners": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "riskLevel": {
+          "type": "string"
+        },
+        "workflow": {
+          "type": "string"
+        }
+      },
+      "title": "Subject",
+      "type": "object"
+    },
+    "InfraApplyUpdateDeviceReply": {
+      "properties": {
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyUpdateDeviceReq": {
+      "properties": {
+        "clearTags": {
+          "type": "boolean"
+        },
+        "code": {
+          "type": "string"
+        },
+        "environment": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "tags": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      },
+      "title": "empty fields are left unchanged",
+      "type": "object"
+    },
+    "InfraApplyUpdateInfraApplyReply": {
+      "properties": {
+        "result": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyUpdateInfraApplyReq": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "apply": {
+          "$ref": "#/definitions/InfraApplyInfraApplyPatch"
+        },
+        "expireTM": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "updateMask": {
+          "$ref": "#/definitions/protobufFieldMask"
+        }
+      },
+      "title": "Update",
+      "type": "object"
+    },
+    "InfraApplyUpdateSubjectReply": {
+      "properties": {
+        "result": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyUpdateSubjectReq": {
+      "properties": {
+        "clearWorkflow": {
+          "type": "boolean"
+        },
+        "defaultDurationSec": {
+          "format": "int64",
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "maxDurationSec": {
+          "format": "int64",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "owners": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "riskLevel": {
+          "type": "string"
+        },
+        "workflow": {
+          "type": "string"
+        }
+      },
+      "title": "empty fields are left unchanged",
+      "type": "object"
+    },
+    "InfraApplyWorkflowDetail": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "stages": {
+          "items": {
+            "$ref": "#/definitions/InfraApplyWorkflowStage"
+          },
+          "type": "array"
+        },
+        "subjects": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "InfraApplyWorkflowStage": {
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "quorum": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "reviewers": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        },
+        "seq": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "title": "Workflow",
+      "type": "object"
+    },
+    "protobufAny": {
+      "properties": {
+        "type_url": {
+          "type": "string"
+        },
+        "value": {
+          "format": "byte",
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "protobufFieldMask": {
+      "properties": {
+        "paths": {
+          "items": {
+            "type": "string"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "runtimeError": {
+      "properties": {
+        "code": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "details": {
+          "items": {
+            "$ref": "#/definitions/protobufAny"
+          },
+          "type": "array"
+        },
+        "error": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "v2AddInfraApplyReply": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "policyRule": {
+          "type": "string"
+        },
+        "result": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "v2AddInfraApplyReq": {
+      "properties": {
+        "deviceCode": {
+          "type": "string"
+        },
+        "expireTime": {
+          "format": "date-time",
+          "type": "string"
+        },
+        "subjectName": {
+          "type": "string"
+        },
+        "uid": {
+          "type": "string"
+        }
+      },
+      "title": "Add",
+      "type": "object"
+    },
+    "v2ExtendInfraApplyReply": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "policyRule": {
+          "type": "string"
+        },
+        "result": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "v2ExtendInfraApplyReq": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "expireTime": {
+          "format": "date-time",
+          "type": "string"
+        },
+        "uid": {
+          "type": "string"
+        }
+      },
+      "title": "Extend",
+      "type": "object"
+    },
+    "v2GetInfraApplyReq": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "title": "Get",
+      "type": "object"
+    },
+    "v2InfraApplyDetail": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "applyer": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "deviceCode": {
+          "type": "string"
+        },
+        "expireTime": {
+          "format": "date-time",
+          "type": "string"
+        },
+        "extendsID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "policyRule": {
+          "type": "string"
+        },
+        "reviewId": {
+          "type": "string"
+        },
+        "reviewTime": {
+          "format": "date-time",
+          "type": "string"
+        },
+        "stage": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "status": {
+          "type": "string"
+        },
+        "subjectName": {
+          "type": "string"
+        },
+        "workflowID": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "type": "object"
+    },
+    "v2InfraApplyReviewDetail": {
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "decision": {
+          "type": "string"
+        },
+        "reviewTime": {
+          "format": "date-time",
+          "type": "string"
+        },
+        "reviewer": {
+          "type": "string"
+        },
+        "stage": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "type": "object"
+    },
+    "v2ListInfraApplyReply": {
+      "properties": {
+        "exhausted": {
+          "type": "boolean"
+        },
+        "page": {
+          "$ref": "#/definitions/v2ModelPage"
+        },
+        "record": {
+          "items": {
+            "$ref": "#/definitions/v2InfraApplyDetail"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "v2ListInfraApplyReq": {
+      "properties": {
+        "applyer": {
+          "type": "string"
+        },
+        "deviceCode": {
+          "type": "string"
+        },
+        "extendsID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageIdx": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageSize": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "search": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "title": "List",
+      "type": "object"
+    },
+    "v2ListInfraApplyReviewReply": {
+      "properties": {
+        "record": {
+          "items": {
+            "$ref": "#/definitions/v2InfraApplyReviewDetail"
+          },
+          "type": "array"
+        }
+      },
+      "type": "object"
+    },
+    "v2ListInfraApplyReviewReq": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "title": "Review",
+      "type": "object"
+    },
+    "v2ModelPage": {
+      "properties": {
+        "pageIdx": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "pageSize": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "total": {
+          "format": "int32",
+          "type": "integer"
+        }
+      },
+      "title": "page message struct",
+      "type": "object"
+    },
+    "v2UpdateInfraApplyReply": {
+      "properties": {
+        "result": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "type": "object"
+    },
+    "v2UpdateInfraApplyReq": {
+      "properties": {
+        "ID": {
+          "format": "int32",
+          "type": "integer"
+        },
+        "expireTime": {
+          "format": "date-time",
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      },
+      "title": "Update",
+      "type": "object"
+    }
+  },
+  "info": {
+    "description": "HTTP gateway of the apiserver. Requests carry a bearer token in the Authorization header.",
+    "title": "big-infra apiserver",
+    "version": "v1"
+  },
+  "paths": {
+    "/InfraApply.DEVICE/AddDevice": {
+      "post": {
+        "operationId": "DEVICE_AddDevice",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyAddDeviceReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyAddDeviceReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Add device",
+        "tags": [
+          "DEVICE"
+        ]
+      }
+    },
+    "/InfraApply.DEVICE/DelDevice": {
+      "post": {
+        "operationId": "DEVICE_DelDevice",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDelDeviceReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDelDeviceReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Delete device",
+        "tags": [
+          "DEVICE"
+        ]
+      }
+    },
+    "/InfraApply.DEVICE/GetDevice": {
+      "post": {
+        "operationId": "DEVICE_GetDevice",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyGetDeviceReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDeviceDetail"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Get device by code",
+        "tags": [
+          "DEVICE"
+        ]
+      }
+    },
+    "/InfraApply.DEVICE/ListDevice": {
+      "post": {
+        "operationId": "DEVICE_ListDevice",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListDeviceReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListDeviceReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "List and search devices",
+        "tags": [
+          "DEVICE"
+        ]
+      }
+    },
+    "/InfraApply.DEVICE/UpdateDevice": {
+      "post": {
+        "operationId": "DEVICE_UpdateDevice",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyUpdateDeviceReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyUpdateDeviceReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Update device",
+        "tags": [
+          "DEVICE"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/AddInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_AddInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyAddInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyAddInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Add infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/BatchUpdateInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_BatchUpdateInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyBatchUpdateInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyBatchUpdateInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Approve or refuse many infra applies at once",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/DelInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_DelInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDelInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDelInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Delete infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/ExtendInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_ExtendInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyExtendInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyExtendInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Ask for a new expiry of a granted apply, the extension is reviewed like a new apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/GetInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_GetInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyGetInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDetailInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Get infra apply by ID",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/GetInfraApplyStats": {
+      "post": {
+        "operationId": "INFRAAPPLY_GetInfraApplyStats",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyGetInfraApplyStatsReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyGetInfraApplyStatsReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Aggregate infra applies for dashboards, from a summary refreshed in the background",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/ImportInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_ImportInfraApply",
+        "parameters": [
+          {
+            "description": " (streaming inputs)",
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyImportInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyImportInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Import existing applies and grants, rows are streamed by the client",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/ListInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_ListInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "List infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/ListInfraApplyReview": {
+      "post": {
+        "operationId": "INFRAAPPLY_ListInfraApplyReview",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListInfraApplyReviewReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListInfraApplyReviewReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "List the reviews of an infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/ListWorkflow": {
+      "post": {
+        "operationId": "INFRAAPPLY_ListWorkflow",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListWorkflowReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListWorkflowReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "List workflows",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/SaveWorkflow": {
+      "post": {
+        "operationId": "INFRAAPPLY_SaveWorkflow",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplySaveWorkflowReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplySaveWorkflowReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Save a workflow and attach it to subjects",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/SearchInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_SearchInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplySearchInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplySearchInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Full-text search of infra applies, the best matches first",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/SimulatePolicy": {
+      "post": {
+        "operationId": "INFRAAPPLY_SimulatePolicy",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplySimulatePolicyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplySimulatePolicyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Evaluate the policy rules for an apply without saving it",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.INFRAAPPLY/UpdateInfraApply": {
+      "post": {
+        "operationId": "INFRAAPPLY_UpdateInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyUpdateInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyUpdateInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Update infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/InfraApply.SUBJECT/AddSubject": {
+      "post": {
+        "operationId": "SUBJECT_AddSubject",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyAddSubjectReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyAddSubjectReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Add subject to the catalog",
+        "tags": [
+          "SUBJECT"
+        ]
+      }
+    },
+    "/InfraApply.SUBJECT/DelSubject": {
+      "post": {
+        "operationId": "SUBJECT_DelSubject",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDelSubjectReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDelSubjectReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Delete subject",
+        "tags": [
+          "SUBJECT"
+        ]
+      }
+    },
+    "/InfraApply.SUBJECT/GetSubject": {
+      "post": {
+        "operationId": "SUBJECT_GetSubject",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyGetSubjectReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplySubjectDetail"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Get subject by name",
+        "tags": [
+          "SUBJECT"
+        ]
+      }
+    },
+    "/InfraApply.SUBJECT/ListSubject": {
+      "post": {
+        "operationId": "SUBJECT_ListSubject",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListSubjectReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListSubjectReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "List and search subjects",
+        "tags": [
+          "SUBJECT"
+        ]
+      }
+    },
+    "/InfraApply.SUBJECT/UpdateSubject": {
+      "post": {
+        "operationId": "SUBJECT_UpdateSubject",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyUpdateSubjectReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyUpdateSubjectReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Update subject",
+        "tags": [
+          "SUBJECT"
+        ]
+      }
+    },
+    "/InfraApply.v2.INFRAAPPLY/AddInfraApply": {
+      "post": {
+        "operationId": "v2_INFRAAPPLY_AddInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2AddInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v2AddInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Add infra apply",
+        "tags": [
+          "INFRAAPPLY v2"
+        ]
+      }
+    },
+    "/InfraApply.v2.INFRAAPPLY/ExtendInfraApply": {
+      "post": {
+        "operationId": "v2_INFRAAPPLY_ExtendInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2ExtendInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v2ExtendInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Ask for a new expiry of a granted apply",
+        "tags": [
+          "INFRAAPPLY v2"
+        ]
+      }
+    },
+    "/InfraApply.v2.INFRAAPPLY/GetInfraApply": {
+      "post": {
+        "operationId": "v2_INFRAAPPLY_GetInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2GetInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v2InfraApplyDetail"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Get infra apply by ID",
+        "tags": [
+          "INFRAAPPLY v2"
+        ]
+      }
+    },
+    "/InfraApply.v2.INFRAAPPLY/ListInfraApply": {
+      "post": {
+        "operationId": "v2_INFRAAPPLY_ListInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2ListInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v2ListInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "List infra apply",
+        "tags": [
+          "INFRAAPPLY v2"
+        ]
+      }
+    },
+    "/InfraApply.v2.INFRAAPPLY/ListInfraApplyReview": {
+      "post": {
+        "operationId": "v2_INFRAAPPLY_ListInfraApplyReview",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2ListInfraApplyReviewReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v2ListInfraApplyReviewReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "List the reviews of an apply",
+        "tags": [
+          "INFRAAPPLY v2"
+        ]
+      }
+    },
+    "/InfraApply.v2.INFRAAPPLY/UpdateInfraApply": {
+      "post": {
+        "operationId": "v2_INFRAAPPLY_UpdateInfraApply",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2UpdateInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v2UpdateInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Update infra apply",
+        "tags": [
+          "INFRAAPPLY v2"
+        ]
+      }
+    },
+    "/v1/applies": {
+      "get": {
+        "operationId": "INFRAAPPLY_ListInfraApply2",
+        "parameters": [
+          {
+            "format": "int32",
+            "in": "query",
+            "name": "pageIdx",
+            "required": false,
+            "type": "integer"
+          },
+          {
+            "format": "int32",
+            "in": "query",
+            "name": "pageSize",
+            "required": false,
+            "type": "integer"
+          },
+          {
+            "in": "query",
+            "name": "search",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "format": "int32",
+            "in": "query",
+            "name": "extendsID",
+            "required": false,
+            "type": "integer"
+          },
+          {
+            "in": "query",
+            "name": "status",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "applyer",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "deviceCode",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyListInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "List infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      },
+      "post": {
+        "operationId": "INFRAAPPLY_AddInfraApply2",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyAddInfraApplyReq"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyAddInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Add infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/v1/applies/{ID}": {
+      "delete": {
+        "operationId": "INFRAAPPLY_DelInfraApply2",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "ID",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "uid",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDelInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Delete infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      },
+      "get": {
+        "operationId": "INFRAAPPLY_GetInfraApply2",
+        "parameters": [
+          {
+            "format": "int32",
+            "in": "path",
+            "name": "ID",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyDetailInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Get infra apply by ID",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      },
+      "patch": {
+        "operationId": "INFRAAPPLY_UpdateInfraApply2",
+        "parameters": [
+          {
+            "format": "int32",
+            "in": "path",
+            "name": "ID",
+            "required": true,
+            "type": "integer"
+          },
+          {
+            "in": "body",
+            "name": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/InfraApplyInfraApplyPatch"
+            }
+          },
+          {
+            "in": "query",
+            "name": "status",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "expireTM",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "collectionFormat": "multi",
+            "in": "query",
+            "items": {
+              "type": "string"
+            },
+            "name": "updateMask.paths",
+            "required": false,
+            "type": "array"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyUpdateInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Update infra apply",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/v1/applies:search": {
+      "get": {
+        "operationId": "INFRAAPPLY_SearchInfraApply2",
+        "parameters": [
+          {
+            "in": "query",
+            "name": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "format": "int32",
+            "in": "query",
+            "name": "pageIdx",
+            "required": false,
+            "type": "integer"
+          },
+          {
+            "format": "int32",
+            "in": "query",
+            "name": "pageSize",
+            "required": false,
+            "type": "integer"
+          },
+          {
+            "in": "query",
+            "name": "status",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "applyer",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "deviceCode",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplySearchInfraApplyReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Full-text search of infra applies, the best matches first",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/v1/applies:stats": {
+      "get": {
+        "operationId": "INFRAAPPLY_GetInfraApplyStats2",
+        "parameters": [
+          {
+            "collectionFormat": "multi",
+            "in": "query",
+            "items": {
+              "type": "string"
+            },
+            "name": "groupBy",
+            "required": false,
+            "type": "array"
+          },
+          {
+            "in": "query",
+            "name": "bucket",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "fromDay",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "toDay",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "status",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "subjectName",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "applyer",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "reviewer",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "format": "int32",
+            "in": "query",
+            "name": "limit",
+            "required": false,
+            "type": "integer"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/InfraApplyGetInfraApplyStatsReply"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "summary": "Aggregate infra applies for dashboards, from a summary refreshed in the background",
+        "tags": [
+          "INFRAAPPLY"
+        ]
+      }
+    },
+    "/v1/export/applies": {
+      "get": {
+        "operationId": "EXPORT_ExportApplies",
+        "parameters": [
+          {
+            "enum": [
+              "csv",
+              "xlsx"
+            ],
+            "in": "query",
+            "name": "format",
+            "type": "string"
+          },
+          {
+            "in": "query",
+            "name": "search",
+            "type": "string"
+          },
+          {
+            "description": "IANA timezone of the times",
+            "in": "query",
+            "name": "tz",
+            "type": "string"
+          }
+        ],
+        "produces": [
+          "text/csv",
+          "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+        ],
+        "responses": {
+          "200": {
+            "description": "The applies.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "401": {
+            "description": "No or bad bearer token."
+          },
+          "403": {
+            "description": "Not an admin."
+          }
+        },
+        "summary": "Export applies as csv or xlsx, admins only",
+        "tags": [
+          "EXPORT"
+        ]
+      }
+    }
+  },
+  "produces": [
+    "application/json"
+  ],
+  "security": [
+    {
+      "bearer": []
+    }
+  ],
+  "securityDefinitions": {
+    "bearer": {
+      "in": "header",
+      "name": "Authorization",
+      "type": "apiKey"
+    }
+  },
+  "swagger": "2.0"
+}
+`
@@ -0,0 +1,164 @@
+package docs
+
+// explorerHTML lists the operations of the document and sends them with the token kept in
+// localStorage. It has no external assets so it works where the gateway has no internet access.
+const explorerHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>big-infra api explorer</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 0; color: #222; }
+header { background: #1b2b3a; color: #fff; padding: 12px 20px; display: flex; align-items: center; gap: 12px; }
+header h1 { font-size: 18px; margin: 0; flex: 1; }
+header input { width: 360px; padding: 4px 6px; }
+main { padding: 12px 20px; }
+h2 { font-size: 16px; border-bottom: 1px solid #ddd; padding-bottom: 4px; margin-top: 24px; }
+.op { border: 1px solid #ddd; border-radius: 4px; margin: 6px 0; }
+.op > .sum { padding: 6px 10px; cursor: pointer; display: flex; gap: 10px; align-items: center; }
+.method { font-weight: bold; width: 60px; text-align: center; color: #fff; border-radius: 3px; padding: 2px 0; font-size: 12px; }
+.get { background: #2f80ed; } .post { background: #27ae60; } .patch { background: #f2994a; }
+.put { background: #9b51e0; } .delete { background: #eb5757; }
+.path { font-family: monospace; }
+.desc { color: #666; }
+.body { display: none; padding: 8px 10px; border-top: 1px solid #eee; }
+.op.open .body { display: block; }
+.param { margin: 4px 0; } .param label { display: inline-block; width: 160px; font-family: monospace; }
+textarea { width: 100%; min-height: 120px; font-family: monospace; }
+pre { background: #f6f8fa; padding: 8px; overflow: auto; max-height: 400px; }
+button { padding: 4px 14px; }
+</style>
+</head>
+<body>
+<header>
+<h1>big-infra api explorer</h1>
+<label>Bearer token <input id="token" type="password" placeholder="token without the Bearer prefix"></label>
+</header>
+<main id="ops">loading {{SPEC_PATH}} ...</main>
+<script>
+(function () {
+  var tokenInput = document.getElementById("token");
+  tokenInput.value = localStorage.getItem("bigInfraToken") || "";
+  tokenInput.addEventListener("change", function () {
+    localStorage.setItem("bigInfraToken", tokenInput.value);
+  });
+
+  function el(tag, attrs, children) {
+    var e = document.createElement(tag);
+    for (var k in attrs || {}) {
+      if (k === "text") { e.textContent = attrs[k]; } else { e.setAttribute(k, attrs[k]); }
+    }
+    (children || []).forEach(function (c) { e.appendChild(c); });
+    return e;
+  }
+
+  // example builds a skeleton value of a schema for the request body
+  function example(spec, schema, depth) {
+    if (!schema || depth > 5) { return null; }
+    if (schema.$ref) {
+      return example(spec, spec.definitions[schema.$ref.replace("#/definitions/", "")], depth + 1);
+    }
+    switch (schema.type) {
+    case "object":
+      var obj = {};
+      for (var name in schema.properties || {}) {
+        obj[name] = example(spec, schema.properties[name], depth + 1);
+      }
+      return obj;
+    case "array":
+      return [];
+    case "integer": case "number":
+      return 0;
+    case "boolean":
+      return false;
+    default:
+      return "";
+    }
+  }
+
+  function render(spec) {
+    var groups = {};
+    Object.keys(spec.paths).sort().forEach(function (path) {
+      var item = spec.paths[path];
+      Object.keys(item).forEach(function (method) {
+        var op = item[method];
+        var tag = (op.tags || ["default"])[0];
+        (groups[tag] = groups[tag] || []).push({ path: path, method: method, op: op });
+      });
+    });
+
+    var root = document.getElementById("ops");
+    root.textContent = "";
+    Object.keys(groups).sort().forEach(function (tag) {
+      root.appendChild(el("h2", { text: tag }));
+      groups[tag].forEach(function (entry) { root.appendChild(operation(spec, entry)); });
+    });
+  }
+
+  function operation(spec, entry) {
+    var op = entry.op, inputs = {}, bodyArea = null;
+    var params = el("div");
+    (op.parameters || []).forEach(function (p) {
+      if (p.in === "body") {
+        bodyArea = el("textarea");
+        bodyArea.value = JSON.stringify(example(spec, p.schema, 0), null, 2);
+        params.appendChild(el("div", { text: "body" }));
+        params.appendChild(bodyArea);
+        return;
+      }
+      var input = el("input", { placeholder: p.in + (p.required ? ", required" : "") });
+      inputs[p.name] = { param: p, input: input };
+      params.appendChild(el("div", { "class": "param" }, [el("label", { text: p.name }), input]));
+    });
+
+    var result = el("pre", { text: "" });
+    var send = el("button", { text: "Send" });
+    send.addEventListener("click", function () {
+      var path = entry.path, query = [];
+      Object.keys(inputs).forEach(function (name) {
+        var p = inputs[name].param, v = inputs[name].input.value;
+        if (p.in === "path") {
+          path = path.replace("{" + name + "}", encodeURIComponent(v));
+        } else if (p.in === "query" && v !== "") {
+          query.push(encodeURIComponent(name) + "=" + encodeURIComponent(v));
+        }
+      });
+      if (query.length) { path += "?" + query.join("&"); }
+
+      var headers = { "Accept": "application/json" };
+      if (tokenInput.value) { headers["Authorization"] = "Bearer " + tokenInput.value; }
+      var init = { method: entry.method.toUpperCase(), headers: headers };
+      if (bodyArea) {
+        headers["Content-Type"] = "application/json";
+        init.body = bodyArea.value;
+      }
+
+      result.textContent = "...";
+      fetch(path, init).then(function (resp) {
+        return resp.text().then(function (text) {
+          try { text = JSON.stringify(JSON.parse(text), null, 2); } catch (e) {}
+          result.textContent = resp.status + " " + resp.statusText + "\n\n" + text;
+        });
+      }).catch(function (err) { result.textContent = String(err); });
+    });
+
+    var box = el("div", { "class": "op" }, [
+      el("div", { "class": "sum" }, [
+        el("span", { "class": "method " + entry.method, text: entry.method.toUpperCase() }),
+        el("span", { "class": "path", text: entry.path }),
+        el("span", { "class": "desc", text: op.summary || "" })
+      ]),
+      el("div", { "class": "body" }, [params, send, result])
+    ]);
+    box.firstChild.addEventListener("click", function () { box.classList.toggle("open"); });
+    return box;
+  }
+
+  fetch("{{SPEC_PATH}}").then(function (resp) { return resp.json(); }).then(render).catch(function (err) {
+    document.getElementById("ops").textContent = "cannot load {{SPEC_PATH}}: " + err;
+  });
+})();
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"os"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// RotateOptions says when the log file is rotated and how long the old files are kept
+type RotateOptions struct {
+	MaxSizeMB  int           // rotate at this size, lumberjack defaults to 100
+	MaxAgeDays int           // remove rotated files older than this, 0 keeps them
+	MaxBackups int           // keep at most this many rotated files, 0 keeps them all
+	Compress   bool          // gzip the rotated files
+	Every      time.Duration // also rotate on this period whatever the size, 0 rotates on size only
+}
+
+// FileWriter writes the log to a file, rotated by size and optionally by age
+type FileWriter struct {
+	*lumberjack.Logger
+	stop chan struct{}
+}
+
+// NewFileWriter opens path for the log, the file is created on the first write
+func NewFileWriter(path string, opts RotateOptions) *FileWriter {
+	w := &FileWriter{
+		Logger: &lumberjack.Logger{
+			Filename:   path,
+			MaxSize:    opts.MaxSizeMB,
+			MaxAge:     opts.MaxAgeDays,
+			MaxBackups: opts.MaxBackups,
+			Compress:   opts.Compress,
+			LocalTime:  true,
+		},
+		stop: make(chan struct{}),
+	}
+	if opts.Every > 0 {
+		go w.rotateEvery(opts.Every)
+	}
+	return w
+}
+
+func (w *FileWriter) rotateEvery(d time.Duration) {
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			// no empty backups for a quiet period
+			if fi, err := os.Stat(w.Filename); err != nil || fi.Size() == 0 {
+				continue
+			}
+			if err := w.Rotate(); err != nil {
+				// the log itself may be broken, so this goes wherever logrus writes now
+				logger.Errorf("rotate log file: %v", err)
+			}
+		}
+	}
+}
+
+// Close stops the periodic rotation and closes the file
+func (w *FileWriter) Close() error {
+	select {
+	case <-w.stop:
+	default:
+		close(w.stop)
+	}
+	return w.Logger.Close()
+}
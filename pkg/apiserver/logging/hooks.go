@@ -0,0 +1,146 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"net/http"
+	"os"
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+	lsyslog "github.com/sirupsen/logrus/hooks/syslog"
+)
+
+// webhookQueue is how many entries wait for the webhook before new ones are dropped
+const webhookQueue = 1024
+
+// NewSyslogHook sends the entries of level and above to syslog, an empty network and addr
+// is the local syslog socket
+func NewSyslogHook(network, addr, tag string, level logger.Level) (logger.Hook, error) {
+	hook, err := lsyslog.NewSyslogHook(network, addr, syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &levelHook{Hook: hook, levels: levelsFrom(level)}, nil
+}
+
+// levelHook limits a hook to some levels
+type levelHook struct {
+	logger.Hook
+	levels []logger.Level
+}
+
+func (h *levelHook) Levels() []logger.Level {
+	return h.levels
+}
+
+// levelsFrom returns level and the levels more severe than it
+func levelsFrom(level logger.Level) []logger.Level {
+	var levels []logger.Level
+	for _, l := range logger.AllLevels {
+		if l <= level {
+			levels = append(levels, l)
+		}
+	}
+	return levels
+}
+
+type webhookEntry struct {
+	Time    time.Time              `json:"time"`
+	Level   string                 `json:"level"`
+	Message string                 `json:"message"`
+	Host    string                 `json:"host"`
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+}
+
+// WebhookHook posts the entries of a level and above as json to a url. Entries are sent
+// in the background, so logging never waits for the webhook, and dropped when it falls behind.
+type WebhookHook struct {
+	url     string
+	headers map[string]string
+	levels  []logger.Level
+	client  *http.Client
+	host    string
+	queue   chan webhookEntry
+}
+
+func NewWebhookHook(url string, headers map[string]string, level logger.Level, timeout time.Duration) *WebhookHook {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	host, _ := os.Hostname()
+	h := &WebhookHook{
+		url:     url,
+		headers: headers,
+		levels:  levelsFrom(level),
+		client:  &http.Client{Timeout: timeout},
+		host:    host,
+		queue:   make(chan webhookEntry, webhookQueue),
+	}
+	go h.run()
+	return h
+}
+
+func (h *WebhookHook) Levels() []logger.Level {
+	return h.levels
+}
+
+// Fire copies the entry, logrus reuses it after the hooks return
+func (h *WebhookHook) Fire(entry *logger.Entry) error {
+	e := webhookEntry{
+		Time:    entry.Time,
+		Level:   entry.Level.String(),
+		Message: entry.Message,
+		Host:    h.host,
+		Fields:  make(map[string]interface{}, len(entry.Data)),
+	}
+	for k, v := range entry.Data {
+		if err, ok := v.(error); ok {
+			v = err.Error()
+		}
+		e.Fields[k] = v
+	}
+
+	select {
+	case h.queue <- e:
+	default:
+		fmt.Fprintf(os.Stderr, "log webhook queue is full, entry dropped: %s\n", e.Message)
+	}
+	return nil
+}
+
+func (h *WebhookHook) run() {
+	for e := range h.queue {
+		if err := h.post(e); err != nil {
+			// logging here would feed the hook again
+			fmt.Fprintf(os.Stderr, "log webhook: %v\n", err)
+		}
+	}
+}
+
+func (h *WebhookHook) post(e webhookEntry) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+// Package logging has the pieces of the server logging: the request scoped logger carried in
+// the context, the rotating log file and the hooks sending entries elsewhere.
+package logging
+
+import (
+	"context"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// fields of the request scoped logger
+const (
+	FieldTraceID = "trace_id"
+	FieldUID     = "uid"
+	FieldMethod  = "method"
+)
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying entry
+func NewContext(ctx context.Context, entry *logger.Entry) context.Context {
+	return context.WithValue(ctx, ctxKey{}, entry)
+}
+
+// FromContext returns the logger of the request of ctx, the standard logger outside a request
+func FromContext(ctx context.Context) *logger.Entry {
+	if ctx != nil {
+		if entry, ok := ctx.Value(ctxKey{}).(*logger.Entry); ok {
+			return entry
+		}
+	}
+	return logger.NewEntry(logger.StandardLogger())
+}
+
+// ForRequest returns the logger of a grpc call
+func ForRequest(traceID, uid, method string) *logger.Entry {
+	return logger.WithFields(logger.Fields{
+		FieldTraceID: traceID,
+		FieldUID:     uid,
+		FieldMethod:  method,
+	})
+}
@@ -0,0 +1,135 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Redacted replaces the value of a redacted field
+const Redacted = "[REDACTED]"
+
+// DefaultRedactFields are redacted whatever the config says
+var DefaultRedactFields = []string{"token", "password", "secret", "authorization"}
+
+const (
+	defaultMaxFieldLen = 256
+	maxListItems       = 20
+)
+
+// Redactor turns requests into log fields, without the sensitive fields and with the large
+// ones truncated
+type Redactor struct {
+	fields       map[string]bool
+	methodFields map[string]map[string]bool
+	noArgs       map[string]bool
+	maxLen       int
+}
+
+// NewRedactor redacts the fields named in fields, a name is the proto field name and matches
+// at any depth, method:name matches only in the requests of the full grpc method. The
+// requests of the noArgs methods are not logged at all.
+func NewRedactor(fields []string, maxLen int, noArgs []string) *Redactor {
+	if maxLen <= 0 {
+		maxLen = defaultMaxFieldLen
+	}
+	r := &Redactor{
+		fields:       make(map[string]bool),
+		methodFields: make(map[string]map[string]bool),
+		noArgs:       make(map[string]bool),
+		maxLen:       maxLen,
+	}
+	all := make([]string, 0, len(DefaultRedactFields)+len(fields))
+	all = append(append(all, DefaultRedactFields...), fields...)
+	for _, f := range all {
+		if i := strings.LastIndex(f, ":"); i >= 0 {
+			method, name := f[:i], strings.ToLower(f[i+1:])
+			if r.methodFields[method] == nil {
+				r.methodFields[method] = make(map[string]bool)
+			}
+			r.methodFields[method][name] = true
+			continue
+		}
+		r.fields[strings.ToLower(f)] = true
+	}
+	for _, m := range noArgs {
+		r.noArgs[m] = true
+	}
+	return r
+}
+
+// Args returns req as a json like value for the log, false if the args of method are not logged
+func (r *Redactor) Args(method string, req interface{}) (interface{}, bool) {
+	if r.noArgs[method] {
+		return nil, false
+	}
+
+	var (
+		raw []byte
+		err error
+	)
+	if msg, ok := req.(proto.Message); ok {
+		var s string
+		s, err = (&jsonpb.Marshaler{OrigName: true}).MarshalToString(msg)
+		raw = []byte(s)
+	} else {
+		raw, err = json.Marshal(req)
+	}
+	if err != nil {
+		return fmt.Sprintf("%T", req), true
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return fmt.Sprintf("%T", req), true
+	}
+	return r.walk(method, v), true
+}
+
+func (r *Redactor) redacted(method, name string) bool {
+	name = strings.ToLower(name)
+	return r.fields[name] || r.methodFields[method][name]
+}
+
+func (r *Redactor) walk(method string, v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if r.redacted(method, k) {
+				val[k] = Redacted
+				continue
+			}
+			val[k] = r.walk(method, item)
+		}
+		return val
+	case []interface{}:
+		n := len(val)
+		if n > maxListItems {
+			val = append(val[:maxListItems], fmt.Sprintf("...(%d more)", n-maxListItems))
+		}
+		for i, item := range val {
+			val[i] = r.walk(method, item)
+		}
+		return val
+	case string:
+		return r.truncate(val)
+	default:
+		return val
+	}
+}
+
+// truncate cuts s to maxLen bytes on a rune boundary
+func (r *Redactor) truncate(s string) string {
+	if len(s) <= r.maxLen {
+		return s
+	}
+	cut := r.maxLen
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s...(%d bytes)", s[:cut], len(s))
+}
@@ -0,0 +1,175 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// Message is a reminder that a grant is about to expire
+type Message struct {
+	Tenant     string        `json:"tenant"`
+	ApplyID    int32         `json:"applyId"`
+	Applyer    string        `json:"applyer"`
+	Subject    string        `json:"subjectName"`
+	DeviceCode string        `json:"deviceCode"`
+	ExpiresAt  time.Time     `json:"expiresAt"`
+	Window     time.Duration `json:"-"`
+}
+
+// Title is the one line summary of m
+func (m *Message) Title() string {
+	return fmt.Sprintf("Your access to %s on %s expires in %v", m.Subject, m.DeviceCode, m.Window)
+}
+
+// Text is the body of m
+func (m *Message) Text() string {
+	return fmt.Sprintf("Hi %s,\n\nyour access to %s on device %s (apply %d) expires at %s.\n"+
+		"Ask for an extension before then if you still need it.\n",
+		m.Applyer, m.Subject, m.DeviceCode, m.ApplyID, m.ExpiresAt.Format(time.RFC3339))
+}
+
+// Channel delivers messages to their applyer
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, m *Message) error
+}
+
+// New news the channel described by cfg
+func New(cfg config.NotifyChannelCfg) (Channel, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	switch cfg.Type {
+	case common.NOTIFY_LOG:
+		return LogChannel{}, nil
+	case common.NOTIFY_WEBHOOK:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("webhook channel without URL")
+		}
+		return &WebhookChannel{url: cfg.URL, headers: cfg.Headers, client: &http.Client{Timeout: timeout}}, nil
+	case common.NOTIFY_SMTP:
+		if cfg.Addr == "" || cfg.From == "" || cfg.Domain == "" {
+			return nil, fmt.Errorf("smtp channel needs Addr, From and Domain")
+		}
+		host, _, err := net.SplitHostPort(cfg.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("smtp channel: %v", err)
+		}
+		ch := &SMTPChannel{addr: cfg.Addr, from: cfg.From, domain: cfg.Domain, timeout: timeout}
+		if cfg.Username != "" {
+			ch.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, host)
+		}
+		return ch, nil
+	}
+
+	return nil, fmt.Errorf("unknown notify channel %q", cfg.Type)
+}
+
+// LogChannel writes messages to the server log, for development or as a fallback
+type LogChannel struct{}
+
+func (LogChannel) Name() string { return common.NOTIFY_LOG }
+
+// Send logs m, it never fails
+func (LogChannel) Send(ctx context.Context, m *Message) error {
+	logger.WithFields(logger.Fields{
+		"applyId": m.ApplyID,
+		"applyer": m.Applyer,
+		"window":  m.Window.String(),
+	}).Info(m.Title())
+	return nil
+}
+
+// WebhookChannel posts messages as json
+type WebhookChannel struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (c *WebhookChannel) Name() string { return common.NOTIFY_WEBHOOK }
+
+// Send posts m with its title and text, any non 2xx status is an error
+func (c *WebhookChannel) Send(ctx context.Context, m *Message) error {
+	body, err := json.Marshal(struct {
+		*Message
+		Window string `json:"window"`
+		Title  string `json:"title"`
+		Text   string `json:"text"`
+	}{m, m.Window.String(), m.Title(), m.Text()})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// SMTPChannel mails messages to uid@domain
+type SMTPChannel struct {
+	addr    string
+	from    string
+	domain  string
+	auth    smtp.Auth
+	timeout time.Duration
+}
+
+func (c *SMTPChannel) Name() string { return common.NOTIFY_SMTP }
+
+// Send mails m as plain text
+func (c *SMTPChannel) Send(ctx context.Context, m *Message) error {
+	to := m.Applyer + "@" + c.domain
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\nTo: %s\r\nSubject: %s\r\n", c.from, to, m.Title())
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.Replace(m.Text(), "\n", "\r\n", -1))
+
+	// smtp.SendMail has no timeout, so bound it by ctx and the channel timeout
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(c.addr, c.auth, c.from, []string{to}, []byte(msg.String()))
+	}()
+
+	timer := time.NewTimer(c.timeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("smtp send to %s timed out", c.addr)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
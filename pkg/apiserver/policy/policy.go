@@ -0,0 +1,167 @@
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Rule is an expression over an apply and the action taken when it matches.
+// The expression is CEL and can use the variables declared in newCelEnv.
+type Rule struct {
+	Name     string
+	Expr     string
+	Action   string // approve|refuse|workflow
+	Workflow string // workflow name, only for the workflow action
+	Priority int32  // lower runs first
+	Tenant   string // the rule only applies to the applies of this tenant, every tenant if empty
+}
+
+// Input is what the rules are evaluated over
+type Input struct {
+	Tenant     string
+	Applyer    string
+	Subject    string
+	DeviceCode string
+	Risk       string        // risk level of the subject, empty if it is not in the catalog
+	Duration   time.Duration // requested grant duration, 0 if no expiry was requested
+	Now        time.Time
+}
+
+// Decision is the action of the first matching rule
+type Decision struct {
+	Rule     string
+	Action   string
+	Workflow string
+}
+
+// Result is the outcome of a single rule
+type Result struct {
+	Rule    string
+	Matched bool
+	Err     error
+}
+
+type compiled struct {
+	Rule
+	prg cel.Program
+}
+
+// Engine holds the compiled rules, it is safe to reload while evaluating
+type Engine struct {
+	env   *cel.Env
+	mu    sync.RWMutex
+	rules []compiled
+}
+
+func newCelEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("tenant", cel.StringType),
+		cel.Variable("applyer", cel.StringType),
+		cel.Variable("subject", cel.StringType),
+		cel.Variable("device_code", cel.StringType),
+		cel.Variable("risk", cel.StringType),
+		cel.Variable("duration", cel.DurationType),
+		cel.Variable("hour", cel.IntType),    // hour of day in server time, 0-23
+		cel.Variable("weekday", cel.IntType), // 0 is Sunday
+		cel.Variable("now", cel.TimestampType),
+	)
+}
+
+// NewEngine news an Engine without rules
+func NewEngine() (*Engine, error) {
+	env, err := newCelEnv()
+	if err != nil {
+		return nil, err
+	}
+	return &Engine{env: env}, nil
+}
+
+// Load compiles rules and replaces the current ones. Nothing is replaced if any rule is invalid.
+func (e *Engine) Load(rules []Rule) error {
+	sorted := make([]Rule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Priority < sorted[j].Priority })
+
+	res := make([]compiled, 0, len(sorted))
+	for _, r := range sorted {
+		switch r.Action {
+		case common.POLICY_APPROVE, common.POLICY_REFUSE:
+		case common.POLICY_WORKFLOW:
+			if r.Workflow == "" {
+				return fmt.Errorf("policy rule %s: workflow action without workflow", r.Name)
+			}
+		default:
+			return fmt.Errorf("policy rule %s: unknown action %q", r.Name, r.Action)
+		}
+
+		ast, iss := e.env.Compile(r.Expr)
+		if iss.Err() != nil {
+			return fmt.Errorf("policy rule %s: %v", r.Name, iss.Err())
+		}
+		if ast.OutputType() != cel.BoolType {
+			return fmt.Errorf("policy rule %s: expression must be bool, got %v", r.Name, ast.OutputType())
+		}
+
+		prg, err := e.env.Program(ast)
+		if err != nil {
+			return fmt.Errorf("policy rule %s: %v", r.Name, err)
+		}
+		res = append(res, compiled{Rule: r, prg: prg})
+	}
+
+	e.mu.Lock()
+	e.rules = res
+	e.mu.Unlock()
+	return nil
+}
+
+// Evaluate runs every rule over in and returns the decision of the first one that matched,
+// or nil if none did. A rule failing to evaluate is reported in its result and does not match.
+// The rules of other tenants are skipped and left out of the results.
+func (e *Engine) Evaluate(in Input) (*Decision, []Result) {
+	e.mu.RLock()
+	rules := e.rules
+	e.mu.RUnlock()
+
+	now := in.Now.Local()
+	vars := map[string]interface{}{
+		"tenant":      in.Tenant,
+		"applyer":     in.Applyer,
+		"subject":     in.Subject,
+		"device_code": in.DeviceCode,
+		"risk":        in.Risk,
+		"duration":    in.Duration,
+		"hour":        now.Hour(),
+		"weekday":     int(now.Weekday()),
+		"now":         now,
+	}
+
+	var decision *Decision
+	results := make([]Result, 0, len(rules))
+	for _, r := range rules {
+		if r.Tenant != "" && r.Tenant != in.Tenant {
+			continue
+		}
+
+		ret := Result{Rule: r.Name}
+		out, _, err := r.prg.Eval(vars)
+		if err != nil {
+			ret.Err = err
+		} else if matched, ok := out.Value().(bool); ok {
+			ret.Matched = matched
+		}
+
+		if ret.Matched && decision == nil {
+			decision = &Decision{Rule: r.Name, Action: r.Action, Workflow: r.Workflow}
+		}
+		results = append(results, ret)
+	}
+
+	return decision, results
+}
@@ -0,0 +1,275 @@
+package replica
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	logger "github.com/sirupsen/logrus"
+)
+
+const _pinKey = "replica:pin"
+
+// Member is a replica reads can go to
+type Member struct {
+	Name   string // for the logs, never the DSN with its password
+	DB     *gorm.DB
+	Weight int // share of the reads, below 1 counts as 1
+}
+
+type member struct {
+	Member
+	healthy bool
+	lag     time.Duration
+	err     string // of the last check, logged when it changes
+}
+
+// Set routes reads to the healthy replicas no further behind the primary than maxLag, picked by
+// weight, and to the primary when there is none. A caller whose db was pinned with WithPin reads
+// the primary for maxLag after its writes, so it sees them.
+type Set struct {
+	primary *gorm.DB
+	maxLag  time.Duration
+
+	mu      sync.RWMutex
+	members []*member
+	pins    map[string]time.Time
+	rnd     *rand.Rand
+}
+
+// New news a Set over primary and members and makes the writes of primary pin their callers. The
+// members are not read before the first check of Run or CheckOnce.
+func New(primary *gorm.DB, members []Member, maxLag time.Duration) *Set {
+	s := &Set{
+		primary: primary,
+		maxLag:  maxLag,
+		pins:    make(map[string]time.Time),
+		rnd:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, m := range members {
+		if m.Weight < 1 {
+			m.Weight = 1
+		}
+		s.members = append(s.members, &member{Member: m})
+	}
+
+	primary.Callback().Create().After("gorm:create").Register("replica:pin", s.pinWrite)
+	primary.Callback().Update().After("gorm:update").Register("replica:pin", s.pinWrite)
+	primary.Callback().Delete().After("gorm:delete").Register("replica:pin", s.pinWrite)
+	return s
+}
+
+// WithPin makes the writes of db pin key to the primary, key identifies the caller
+func WithPin(db *gorm.DB, key string) *gorm.DB {
+	return db.Set(_pinKey, key)
+}
+
+func (s *Set) pinWrite(scope *gorm.Scope) {
+	if scope.HasError() {
+		return
+	}
+	if key, ok := scope.Get(_pinKey); ok {
+		s.Pin(key.(string))
+	}
+}
+
+// Pin makes key read the primary for maxLag
+func (s *Set) Pin(key string) {
+	if len(s.members) == 0 {
+		return
+	}
+	s.mu.Lock()
+	s.pins[key] = time.Now().Add(s.maxLag)
+	s.mu.Unlock()
+}
+
+// Pinned reports whether key reads the primary after a write of its own
+func (s *Set) Pinned(key string) bool {
+	if len(s.members) == 0 {
+		return false
+	}
+	s.mu.RLock()
+	until, ok := s.pins[key]
+	s.mu.RUnlock()
+	return ok && time.Now().Before(until)
+}
+
+// Behind reports whether a replica may not have caught up with a write made at t yet
+func (s *Set) Behind(t time.Time) bool {
+	return len(s.members) > 0 && time.Since(t) < s.maxLag
+}
+
+// Primary returns the db of the primary
+func (s *Set) Primary() *gorm.DB {
+	return s.primary
+}
+
+// Reader returns the db the reads of key go to
+func (s *Set) Reader(key string) *gorm.DB {
+	if len(s.members) == 0 {
+		return s.primary
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if until, ok := s.pins[key]; ok {
+		if time.Now().Before(until) {
+			return s.primary
+		}
+		delete(s.pins, key)
+	}
+
+	total := 0
+	for _, m := range s.members {
+		if s.readable(m) {
+			total += m.Weight
+		}
+	}
+	if total == 0 {
+		return s.primary
+	}
+
+	n := s.rnd.Intn(total)
+	for _, m := range s.members {
+		if !s.readable(m) {
+			continue
+		}
+		if n < m.Weight {
+			return m.DB
+		}
+		n -= m.Weight
+	}
+	return s.primary
+}
+
+func (s *Set) readable(m *member) bool {
+	return m.healthy && m.lag <= s.maxLag
+}
+
+// Run checks the members every interval until ctx is done
+func (s *Set) Run(ctx context.Context, interval time.Duration) {
+	logger.Infof("replica checks started, %d replicas every %v", len(s.members), interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		s.CheckOnce(checkCtx)
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckOnce pings every member and reads its replication lag, a member failing either is not read
+// until it passes again. It also drops the pins that ran out.
+func (s *Set) CheckOnce(ctx context.Context) {
+	type result struct {
+		lag time.Duration
+		err error
+	}
+	results := make([]result, len(s.members))
+
+	var wg sync.WaitGroup
+	for i, m := range s.members {
+		wg.Add(1)
+		go func(i int, db *sql.DB) {
+			defer wg.Done()
+			if err := db.PingContext(ctx); err != nil {
+				results[i].err = err
+				return
+			}
+			results[i].lag, results[i].err = replicationLag(ctx, db)
+		}(i, m.DB.DB())
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, m := range s.members {
+		r := results[i]
+		m.healthy, m.lag = r.err == nil, r.lag
+
+		msg := ""
+		if r.err != nil {
+			msg = r.err.Error()
+		} else if r.lag > s.maxLag {
+			msg = "lag " + r.lag.String()
+		}
+		if msg != m.err {
+			if msg == "" {
+				logger.Infof("replica %s is read again", m.Name)
+			} else {
+				logger.Warnf("replica %s is not read: %s", m.Name, msg)
+			}
+			m.err = msg
+		}
+	}
+
+	now := time.Now()
+	for key, until := range s.pins {
+		if !now.Before(until) {
+			delete(s.pins, key)
+		}
+	}
+}
+
+// Close closes the dbs of the members
+func (s *Set) Close() {
+	for _, m := range s.members {
+		m.DB.Close()
+	}
+}
+
+// replicationLag reads how far db is behind its source, a server that is no replica is not behind
+func replicationLag(ctx context.Context, db *sql.DB) (time.Duration, error) {
+	// SHOW SLAVE STATUS for the servers older than 8.0.22
+	rows, err := db.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		if rows, err = db.QueryContext(ctx, "SHOW SLAVE STATUS"); err != nil {
+			return 0, err
+		}
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		if values[i] == nil {
+			return 0, errors.New("replication is not running")
+		}
+		sec, err := strconv.Atoi(string(values[i]))
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(sec) * time.Second, nil
+	}
+	return 0, errors.New("replication lag not found in the replica status")
+}
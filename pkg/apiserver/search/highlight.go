@@ -0,0 +1,81 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+)
+
+// HighlightStart and HighlightEnd surround the matches in a highlighted value
+const (
+	HighlightStart = "<em>"
+	HighlightEnd   = "</em>"
+)
+
+type span struct {
+	start, end int
+}
+
+// Highlight returns s html escaped with the matches of the terms of q between HighlightStart and
+// HighlightEnd, ok is false when nothing of s matches
+func (q *Query) Highlight(s string) (string, bool) {
+	ws := words(s)
+	var spans []span
+	for _, t := range q.Terms {
+		if t.Exclude {
+			continue
+		}
+		for i := 0; i+len(t.Words) <= len(ws); i++ {
+			if t.matchAt(ws, i) {
+				spans = append(spans, span{ws[i].start, ws[i+len(t.Words)-1].end})
+			}
+		}
+	}
+	if len(spans) == 0 {
+		return "", false
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var b strings.Builder
+	last := 0
+	for _, sp := range merge(spans) {
+		b.WriteString(html.EscapeString(s[last:sp.start]))
+		b.WriteString(HighlightStart)
+		b.WriteString(html.EscapeString(s[sp.start:sp.end]))
+		b.WriteString(HighlightEnd)
+		last = sp.end
+	}
+	b.WriteString(html.EscapeString(s[last:]))
+	return b.String(), true
+}
+
+// matchAt reports whether the words of t start at ws[i]
+func (t *Term) matchAt(ws []word, i int) bool {
+	for j, w := range t.Words {
+		text := ws[i+j].text
+		if t.Prefix && j == len(t.Words)-1 {
+			if !strings.HasPrefix(text, w) {
+				return false
+			}
+		} else if text != w {
+			return false
+		}
+	}
+	return true
+}
+
+// merge joins the overlapping spans, spans are sorted by start
+func merge(spans []span) []span {
+	res := spans[:1]
+	for _, sp := range spans[1:] {
+		cur := &res[len(res)-1]
+		if sp.start <= cur.end {
+			if sp.end > cur.end {
+				cur.end = sp.end
+			}
+			continue
+		}
+		res = append(res, sp)
+	}
+	return res
+}
@@ -0,0 +1,161 @@
+// Package search parses the full-text queries over applies into MySQL boolean mode expressions
+// and highlights their matches.
+//
+// A query is made of words, all of them must match. word* matches the words starting with word,
+// "some words" matches them next to each other and -word excludes the applies matching word.
+package search
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// DefaultMinTokenLen is innodb_ft_min_token_size, shorter words are not in a FULLTEXT index
+const DefaultMinTokenLen = 3
+
+// maxTerms bounds the work of a single query
+const maxTerms = 16
+
+// Term is a word, a prefix or a phrase of a query
+type Term struct {
+	Words   []string // lower case, more than one for a phrase
+	Prefix  bool     // the last word is a prefix
+	Exclude bool
+}
+
+// Query is a parsed full-text query
+type Query struct {
+	Terms []Term
+}
+
+// Parse parses q, the words shorter than minTokenLen can not be searched and are dropped.
+// A query left without any term to include is an error.
+func Parse(q string, minTokenLen int) (*Query, error) {
+	if minTokenLen <= 0 {
+		minTokenLen = DefaultMinTokenLen
+	}
+
+	var query Query
+	for _, raw := range split(q) {
+		var t Term
+		if strings.HasPrefix(raw, "-") {
+			t.Exclude, raw = true, raw[1:]
+		}
+		phrase := strings.HasPrefix(raw, `"`)
+		raw = strings.Trim(raw, `"`)
+		if !phrase && strings.HasSuffix(raw, "*") {
+			t.Prefix, raw = true, strings.TrimRight(raw, "*")
+		}
+
+		// the index splits words on punctuation too, device-001 is the phrase "device 001"
+		all := words(raw)
+		for _, w := range all {
+			if len(w.text) >= minTokenLen {
+				t.Words = append(t.Words, w.text)
+			}
+		}
+		switch {
+		case len(t.Words) == 0:
+		case len(t.Words) == len(all):
+			query.Terms = append(query.Terms, t)
+		case t.Exclude:
+			// what is left of the phrase would exclude more than asked, so nothing is
+		default:
+			// the phrase is not in the index as it was written, its remaining words still must match
+			prefix := t.Prefix && len(all[len(all)-1].text) >= minTokenLen
+			for i, w := range t.Words {
+				query.Terms = append(query.Terms, Term{Words: []string{w}, Prefix: prefix && i == len(t.Words)-1})
+			}
+		}
+	}
+
+	if len(query.Terms) > maxTerms {
+		return nil, errors.New("too many words")
+	}
+	for _, t := range query.Terms {
+		if !t.Exclude {
+			return &query, nil
+		}
+	}
+	return nil, errors.New("no searchable word")
+}
+
+// split cuts q on spaces, keeping the quoted phrases whole with their quotes
+func split(q string) []string {
+	var (
+		res    []string
+		cur    strings.Builder
+		quoted bool
+	)
+	for _, r := range q {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			cur.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if cur.Len() > 0 {
+				res = append(res, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if cur.Len() > 0 {
+		res = append(res, cur.String())
+	}
+	return res
+}
+
+// Boolean returns q as the argument of MATCH ... AGAINST (? IN BOOLEAN MODE)
+func (q *Query) Boolean() string {
+	parts := make([]string, 0, len(q.Terms))
+	for _, t := range q.Terms {
+		op := "+"
+		if t.Exclude {
+			op = "-"
+		}
+		switch {
+		case len(t.Words) > 1 && t.Prefix && !t.Exclude:
+			// a phrase can not end with a prefix in boolean mode, require its words instead
+			for _, w := range t.Words[:len(t.Words)-1] {
+				parts = append(parts, "+"+w)
+			}
+			parts = append(parts, "+"+t.Words[len(t.Words)-1]+"*")
+		case len(t.Words) > 1:
+			parts = append(parts, op+`"`+strings.Join(t.Words, " ")+`"`)
+		case t.Prefix:
+			parts = append(parts, op+t.Words[0]+"*")
+		default:
+			parts = append(parts, op+t.Words[0])
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+type word struct {
+	text       string // lower case
+	start, end int    // byte offsets in the original string
+}
+
+// words returns the runs of letters and digits of s
+func words(s string) []word {
+	var (
+		res   []word
+		start = -1
+	)
+	for i, r := range s {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+		if inWord && start < 0 {
+			start = i
+		} else if !inWord && start >= 0 {
+			res = append(res, word{text: strings.ToLower(s[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		res = append(res, word{text: strings.ToLower(s[start:]), start: start, end: len(s)})
+	}
+	return res
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// FindOneDevice returns the device with code, or nil if there is none
+func FindOneDevice(mysqlCli *gorm.DB, code string) (*model.Device, error) {
+	return common.FindOne[model.Device](mysqlCli, common.NewQuery().Eq("code", code))
+}
+
+// FindDeviceByCodes returns the devices with the given codes keyed by code
+func FindDeviceByCodes(mysqlCli *gorm.DB, codes []string) (map[string]*model.Device, error) {
+	devices := make(map[string]*model.Device)
+	if len(codes) == 0 {
+		return devices, nil
+	}
+
+	res, _, err := common.Find[model.Device](mysqlCli, common.NewQuery().In("code", codes))
+	if err != nil {
+		return nil, err
+	}
+	for i := range res {
+		devices[res[i].Code] = &res[i]
+	}
+	return devices, nil
+}
+
+// FindDeviceLikePattern returns a page of devices matching query, whose code or name is like
+// search and that carry tag, empty search and tag match all
+func FindDeviceLikePattern(mysqlCli *gorm.DB, query map[string]interface{}, search, tag string,
+	limit, offset int32) ([]model.Device, int, error) {
+	q := common.NewQuery().Match(query).Page(limit, offset)
+	if search != "" {
+		q.Like("CONCAT(code, ' ', name)", search)
+	}
+	if tag != "" {
+		q.Like("CONCAT(',', tags, ',')", ","+tag+",")
+	}
+
+	return common.Find[model.Device](mysqlCli, q)
+}
+
+// UpdateDevice writes the changed fields of a device
+func UpdateDevice(mysqlCli *gorm.DB, d *model.Device, m map[string]interface{}) error {
+	return mysqlCli.Model(d).Updates(m).Error
+}
+
+// DeleteDevice deletes a device
+func DeleteDevice(mysqlCli *gorm.DB, d *model.Device) error {
+	return mysqlCli.Delete(d).Error
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/json"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// FindPendingExtension returns the extension request of grantID still waiting for review, or nil
+func FindPendingExtension(mysqlCli *gorm.DB, grantID int32) (*model.InfraApply, error) {
+	var ia model.InfraApply
+	err := mysqlCli.Where("extends_id = ? AND status = ?", grantID, common.STATUS_INIT).First(&ia).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &ia, nil
+}
+
+// ExtendGrant moves the expiry of the grant extended by the approved extension ext to expiresAt,
+// an expired grant is renewed. The change is kept in the audit log of the grant, so the log and
+// the extension requests listed by extends_id form the history of the grant. It should be
+// called in a transaction.
+func ExtendGrant(tx *gorm.DB, ext *model.InfraApply, expiresAt time.Time, actor string) error {
+	grant, err := FindOneInfraApply(tx, map[string]interface{}{"id": ext.ExtendsID})
+	if err != nil {
+		return err
+	}
+	if grant == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	updater := map[string]interface{}{"expires_at": expiresAt}
+	if grant.Status == common.STATUS_EXPIRED {
+		updater["status"] = common.STATUS_APPROVED
+	}
+	if err := UpdateInfraApply(tx, grant, updater); err != nil {
+		return err
+	}
+
+	detail, _ := json.Marshal(map[string]interface{}{
+		"extensionID": ext.ID,
+		"from":        grant.ExpiresAt,
+		"to":          expiresAt,
+		"status":      grant.Status,
+	})
+	audit := model.AuditLog{
+		Actor:   actor,
+		Action:  common.AUDIT_EXTEND,
+		ApplyID: grant.ID,
+		Detail:  string(detail),
+	}
+	return common.AddOne(tx, &audit)
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// ClaimIdempotencyKey inserts rec as a placeholder for its key of its caller. If the key of the
+// caller is already taken by a record that has not expired, that record is returned and claimed
+// is false.
+func ClaimIdempotencyKey(mysqlCli *gorm.DB, rec *model.IdempotencyKey) (*model.IdempotencyKey, bool, error) {
+	err := mysqlCli.Where("uid = ? AND idem_key = ? AND expires_at < ?", rec.Uid, rec.Key, time.Now()).
+		Delete(&model.IdempotencyKey{}).Error
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = common.AddOne(mysqlCli, rec)
+	if err == nil {
+		return rec, true, nil
+	}
+
+	// the insert failed, most likely on the primary key, so look for the owner of the key
+	var exist model.IdempotencyKey
+	ferr := mysqlCli.Where("uid = ? AND idem_key = ?", rec.Uid, rec.Key).First(&exist).Error
+	if ferr != nil {
+		if gorm.IsRecordNotFoundError(ferr) {
+			return nil, false, err
+		}
+		return nil, false, ferr
+	}
+
+	return &exist, false, nil
+}
+
+// SaveIdempotencyResponse stores the response of a finished request under the key of uid
+func SaveIdempotencyResponse(mysqlCli *gorm.DB, uid, key, respType string, resp []byte) error {
+	var db = mysqlCli.Model(&model.IdempotencyKey{}).Where("uid = ? AND idem_key = ?", uid, key)
+	return db.Updates(map[string]interface{}{"resp_type": respType, "response": resp}).Error
+}
+
+// ReleaseIdempotencyKey drops the key of uid so a failed request can be retried with it
+func ReleaseIdempotencyKey(mysqlCli *gorm.DB, uid, key string) error {
+	return mysqlCli.Where("uid = ? AND idem_key = ?", uid, key).Delete(&model.IdempotencyKey{}).Error
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"errors"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// ValidateInfraApply checks the fields every apply must have
+func ValidateInfraApply(ia *model.InfraApply) error {
+	if ia.DeviceCode == "" || ia.Applyer == "" || ia.SubjectName == "" {
+		return errors.New("deviceCode, uid and subjectName are required")
+	}
+
+	switch ia.Status {
+	case common.STATUS_INIT, common.STATUS_REFUSED, common.STATUS_APPROVED, common.STATUS_EXPIRED:
+	default:
+		return errors.New("status must be init, refused, approved or expired")
+	}
+
+	if ia.Status != common.STATUS_INIT && ia.ReviewedAt.IsZero() {
+		return errors.New("reviewed apply needs reviewTM")
+	}
+	if !ia.ExpiresAt.IsZero() && !ia.ReviewedAt.IsZero() && ia.ExpiresAt.Before(ia.ReviewedAt) {
+		return errors.New("expireTM is before reviewTM")
+	}
+
+	return nil
+}
+
+// FindInfraApplyByNaturalKey returns the latest apply of applyer for subjectName on deviceCode,
+// extension requests aside, or nil if there is none
+func FindInfraApplyByNaturalKey(mysqlCli *gorm.DB, applyer, deviceCode, subjectName string) (*model.InfraApply, error) {
+	var ia model.InfraApply
+	err := mysqlCli.Where("applyer = ? AND device_code = ? AND subject_name = ? AND extends_id = 0",
+		applyer, deviceCode, subjectName).
+		Order("id DESC").First(&ia).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &ia, nil
+}
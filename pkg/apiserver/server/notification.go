@@ -0,0 +1,58 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// NotificationKey identifies the reminder of an apply for a window. The expiry is part of the key
+// so that an apply whose expiry moved is reminded again.
+func NotificationKey(applyID int32, window time.Duration, expiresAt time.Time) string {
+	return fmt.Sprintf("%d:%d:%d", applyID, int64(window/time.Second), expiresAt.Unix())
+}
+
+// FindExpiringInfraApply returns approved grants expiring in (from, to] with an id above afterID,
+// ordered by id. Extension requests are left out, the grant they extend carries their expiry.
+func FindExpiringInfraApply(mysqlCli *gorm.DB, from, to time.Time, afterID, limit int32) ([]model.InfraApply, error) {
+	var res []model.InfraApply
+	err := mysqlCli.Where("status = ? AND extends_id = 0 AND expires_at > ? AND expires_at <= ? AND id > ?",
+		common.STATUS_APPROVED, from, to, afterID).Order("id").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+// ClaimNotification inserts rec, claimed is false if its key was already taken, by this
+// or another replica
+func ClaimNotification(mysqlCli *gorm.DB, rec *model.Notification) (bool, error) {
+	err := common.AddOne(mysqlCli, rec)
+	if err == nil {
+		return true, nil
+	}
+
+	// the insert failed, most likely on the primary key
+	var exist model.Notification
+	ferr := mysqlCli.Where("notify_key = ?", rec.Key).First(&exist).Error
+	if ferr != nil {
+		if gorm.IsRecordNotFoundError(ferr) {
+			return false, err
+		}
+		return false, ferr
+	}
+
+	return false, nil
+}
+
+// UpdateNotification writes the outcome of sending a claimed notification
+func UpdateNotification(mysqlCli *gorm.DB, key string, m map[string]interface{}) error {
+	_, err := common.Update[model.Notification](mysqlCli, common.NewQuery().Eq("notify_key", key), m)
+	return err
+}
+
+// ReleaseNotification drops a claim so the reminder is tried again
+func ReleaseNotification(mysqlCli *gorm.DB, key string) error {
+	_, err := common.Delete[model.Notification](mysqlCli, common.NewQuery().Eq("notify_key", key))
+	return err
+}
@@ -0,0 +1,13 @@
+package server
+
+import (
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// FindPolicyRules returns the enabled policy rules ordered by priority
+func FindPolicyRules(mysqlCli *gorm.DB) ([]model.PolicyRule, error) {
+	var rules []model.PolicyRule
+	err := mysqlCli.Where("enabled = ?", true).Order("priority").Order("id").Find(&rules).Error
+	return rules, err
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// SearchColumns are the columns of the FULLTEXT index of the applies, the index is
+//
+//	ALTER TABLE t_subject_apply ADD FULLTEXT INDEX ft_apply_search (subject_name, applyer, device_code, comment)
+//
+// MATCH needs the exact column list of an index, keep them the same
+const SearchColumns = "subject_name, applyer, device_code, comment"
+
+const _matchApply = "MATCH (" + SearchColumns + ") AGAINST (? IN BOOLEAN MODE)"
+
+// InfraApplyHit is an apply found by SearchInfraApply with its relevance
+type InfraApplyHit struct {
+	model.InfraApply
+	Score float64 `gorm:"column:score"`
+}
+
+// SearchInfraApply returns the applies matching query whose columns match the boolean mode
+// expression match, the most relevant first, and how many there are in all
+func SearchInfraApply(mysqlCli *gorm.DB, query map[string]interface{}, match string,
+	limit, offset int32) ([]InfraApplyHit, int, error) {
+	var ia model.InfraApply
+	db := mysqlCli.Table(ia.TableName()).Where(query).Where(_matchApply, match)
+
+	var total int
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	var res []InfraApplyHit
+	err := db.Select("*, "+_matchApply+" AS score", match).
+		Order("score DESC").Order("id DESC").
+		Limit(limit).Offset(offset).
+		Scan(&res).Error
+	return res, total, err
+}
@@ -1,6 +1,9 @@
 package server
 
 import (
+	"context"
+	"database/sql"
+
 	"big-infra/pkg/apiserver/common"
 	"big-infra/pkg/model"
 	"github.com/jinzhu/gorm"
@@ -8,18 +11,29 @@ import (
 
 func FindInfraApplyLikePattern(mysqlCli *gorm.DB, query map[string]interface{},
 	search map[string]interface{}, limit, offset int32) ([]model.InfraApply, int, error) {
-	S := make(map[string]interface{})
+	q := common.NewQuery().Match(query).Page(limit, offset)
 	for k, v := range search {
-		field := k + " LIKE ?"
-		S[field] = "%" + v.(string) + "%"
+		q.Like(k, v.(string))
 	}
 
-	res, total, err := common.FindLike(mysqlCli, &model.InfraApply{}, query, S, limit, offset)
-	if err != nil {
-		return nil, 0, err
+	return common.Find[model.InfraApply](mysqlCli, q)
+}
+
+// InfraApplyRowsLikePattern opens a cursor over all the applies matching query and search,
+// the caller reads it with ScanInfraApply and must close it
+func InfraApplyRowsLikePattern(mysqlCli *gorm.DB, query map[string]interface{},
+	search map[string]interface{}) (*sql.Rows, error) {
+	var ia model.InfraApply
+	Q := mysqlCli.Table(ia.TableName()).Where(query)
+	for k, v := range search {
+		Q = Q.Where(common.LikeContains(k, v.(string)))
 	}
+	return Q.Order("id").Rows()
+}
 
-	return res.([]model.InfraApply), total, nil
+// ScanInfraApply reads the current row of rows into ia
+func ScanInfraApply(mysqlCli *gorm.DB, rows *sql.Rows, ia *model.InfraApply) error {
+	return mysqlCli.ScanRows(rows, ia)
 }
 
 //func CheckUserHasPermission(mysqlCli *gorm.DB, uid, serviceName string) (bool, error) {
@@ -47,19 +61,25 @@ func FindInfraApplyLikePattern(mysqlCli *gorm.DB, query map[string]interface{},
 //}
 
 func FindOneInfraApply(mysqlCli *gorm.DB, query map[string]interface{}) (*model.InfraApply, error) {
-	res, total, err := common.Find(mysqlCli, &model.InfraApply{}, query, 1, 0)
-	if err != nil {
-		return nil, err
-	}
-
-	if total <= 0 {
-		return nil, nil
-	}
+	return common.FindOne[model.InfraApply](mysqlCli, common.NewQuery().Match(query))
+}
 
-	return &res.([]model.InfraApply)[0], nil
+// LockInfraApply returns the apply id locked until the end of tx, or nil if there is none
+func LockInfraApply(tx *gorm.DB, id int32) (*model.InfraApply, error) {
+	return common.FindOne[model.InfraApply](tx, common.NewQuery().Eq("id", id).ForUpdate())
 }
 
 func UpdateInfraApply(mysqlCli *gorm.DB, ia *model.InfraApply, m map[string]interface{}) error {
 	var db = mysqlCli.Model(ia)
 	return db.Updates(m).Error
 }
+
+// DeleteInfraApply deletes an apply with its reviews
+func DeleteInfraApply(mysqlCli *gorm.DB, ia *model.InfraApply) error {
+	return common.WithTx(context.Background(), mysqlCli, common.TxOptions{}, func(_ context.Context, tx *gorm.DB) error {
+		if err := tx.Where("apply_id = ?", ia.ID).Delete(&model.InfraApplyReview{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(ia).Error
+	})
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"database/sql"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// InfraApplySummaryRows opens a cursor over the columns of all the applies the summary is built
+// from, the caller reads it with ScanInfraApply and must close it
+func InfraApplySummaryRows(mysqlCli *gorm.DB) (*sql.Rows, error) {
+	var ia model.InfraApply
+	return mysqlCli.Table(ia.TableName()).
+		Select("id, tenant_id, status, subject_name, applyer, review_id, expires_at, review_at, created_at, extends_id").
+		Order("id").Rows()
+}
+
+// ReplaceApplyStats replaces the summary seen by mysqlCli with rows
+func ReplaceApplyStats(mysqlCli *gorm.DB, rows []model.ApplyStat) error {
+	if err := mysqlCli.Delete(&model.ApplyStat{}).Error; err != nil {
+		return err
+	}
+	for i := range rows {
+		if err := common.AddOne(mysqlCli, &rows[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindApplyStats returns the summary rows matching query of the days in [fromDay, toDay),
+// empty days leave the range open
+func FindApplyStats(mysqlCli *gorm.DB, query map[string]interface{}, fromDay, toDay string) ([]model.ApplyStat, error) {
+	db := mysqlCli.Where(query)
+	if fromDay != "" {
+		db = db.Where("day >= ?", fromDay)
+	}
+	if toDay != "" {
+		db = db.Where("day < ?", toDay)
+	}
+
+	var res []model.ApplyStat
+	err := db.Find(&res).Error
+	return res, err
+}
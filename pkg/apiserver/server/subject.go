@@ -0,0 +1,37 @@
+package server
+
+import (
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+// FindOneSubject returns the subject with name, or nil if it is not in the catalog
+func FindOneSubject(mysqlCli *gorm.DB, name string) (*model.Subject, error) {
+	return common.FindOne[model.Subject](mysqlCli, common.NewQuery().Eq("name", name))
+}
+
+// FindSubjectLikePattern returns a page of subjects matching query, whose name or description
+// is like search and that are owned by owner, empty search and owner match all
+func FindSubjectLikePattern(mysqlCli *gorm.DB, query map[string]interface{}, search, owner string,
+	limit, offset int32) ([]model.Subject, int, error) {
+	q := common.NewQuery().Match(query).Page(limit, offset)
+	if search != "" {
+		q.Like("CONCAT(name, ' ', description)", search)
+	}
+	if owner != "" {
+		q.Like("CONCAT(',', owners, ',')", ","+owner+",")
+	}
+
+	return common.Find[model.Subject](mysqlCli, q)
+}
+
+// UpdateSubject writes the changed fields of a subject
+func UpdateSubject(mysqlCli *gorm.DB, sub *model.Subject, m map[string]interface{}) error {
+	return mysqlCli.Model(sub).Updates(m).Error
+}
+
+// DeleteSubject deletes a subject
+func DeleteSubject(mysqlCli *gorm.DB, sub *model.Subject) error {
+	return mysqlCli.Delete(sub).Error
+}
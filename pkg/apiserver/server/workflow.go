@@ -0,0 +1,300 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	ErrReviewClosed     = errors.New("apply is not waiting for review")
+	ErrInvalidDecision  = errors.New("workflow review must be approved or refused")
+	ErrNotStageReviewer = errors.New("user is not a reviewer of the current stage")
+	ErrAlreadyReviewed  = errors.New("user already reviewed the current stage")
+	ErrNoReviewer       = errors.New("workflow review needs an authenticated reviewer")
+)
+
+// FindSubjectWorkflow returns the workflow attached to subjectName, or nil if there is none
+func FindSubjectWorkflow(mysqlCli *gorm.DB, subjectName string) (*model.Workflow, error) {
+	var sw model.SubjectWorkflow
+	err := mysqlCli.Where("subject_name = ?", subjectName).First(&sw).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var wf model.Workflow
+	err = mysqlCli.Where("id = ?", sw.WorkflowID).First(&wf).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &wf, nil
+}
+
+// FindWorkflowStages returns all stages of a workflow ordered by sequence
+func FindWorkflowStages(mysqlCli *gorm.DB, workflowID int32) ([]model.WorkflowStage, error) {
+	var stages []model.WorkflowStage
+	err := mysqlCli.Where("workflow_id = ?", workflowID).Order("seq").Find(&stages).Error
+	return stages, err
+}
+
+// FindWorkflowSubjects returns the subjects attached to a workflow
+func FindWorkflowSubjects(mysqlCli *gorm.DB, workflowID int32) ([]string, error) {
+	var sws []model.SubjectWorkflow
+	err := mysqlCli.Where("workflow_id = ?", workflowID).Find(&sws).Error
+	if err != nil {
+		return nil, err
+	}
+
+	subjects := make([]string, 0, len(sws))
+	for _, sw := range sws {
+		subjects = append(subjects, sw.SubjectName)
+	}
+	return subjects, nil
+}
+
+// NextWorkflowStage returns the first stage sequence of a workflow after seq,
+// ok is false if seq is the last stage
+func NextWorkflowStage(mysqlCli *gorm.DB, workflowID, seq int32) (next int32, ok bool, err error) {
+	var stage model.WorkflowStage
+	err = mysqlCli.Where("workflow_id = ? AND seq > ?", workflowID, seq).Order("seq").First(&stage).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return stage.Seq, true, nil
+}
+
+// SaveWorkflow stores wf and its stages as a new version and attaches it to subjects
+func SaveWorkflow(tx *gorm.DB, wf *model.Workflow, stages []model.WorkflowStage, subjects []string) error {
+	if err := common.AddOne(tx, wf); err != nil {
+		return err
+	}
+
+	for i := range stages {
+		stages[i].WorkflowID = wf.ID
+		if err := common.AddOne(tx, &stages[i]); err != nil {
+			return err
+		}
+	}
+
+	for _, subject := range subjects {
+		var sw model.SubjectWorkflow
+		err := tx.Where(model.SubjectWorkflow{SubjectName: subject}).
+			Assign(model.SubjectWorkflow{WorkflowID: wf.ID}).FirstOrCreate(&sw).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindInfraApplyReviews returns the reviews of an apply in the order they were made
+func FindInfraApplyReviews(mysqlCli *gorm.DB, applyID int32) ([]model.InfraApplyReview, error) {
+	var reviews []model.InfraApplyReview
+	err := mysqlCli.Where("apply_id = ?", applyID).Order("id").Find(&reviews).Error
+	return reviews, err
+}
+
+// ReviewInfraApply records review on ia and moves it through its workflow. updater holds the
+// columns written once the apply is decided, an apply without workflow is decided at once.
+// It returns the status of the apply after the review, and should be called in a transaction.
+// The reviewer of a workflow apply must be the verified identity of the caller, the stages and
+// the quorum count it.
+func ReviewInfraApply(tx *gorm.DB, ia *model.InfraApply, review *model.InfraApplyReview,
+	updater map[string]interface{}) (string, error) {
+	review.ApplyID = ia.ID
+	if ia.WorkflowID == 0 {
+		if err := common.AddOne(tx, review); err != nil {
+			return "", err
+		}
+		if err := UpdateInfraApply(tx, ia, updater); err != nil {
+			return "", err
+		}
+		return review.Decision, nil
+	}
+
+	if review.Reviewer == "" {
+		return "", ErrNoReviewer
+	}
+	if ia.Status != common.STATUS_INIT {
+		return "", ErrReviewClosed
+	}
+	if review.Decision != common.STATUS_APPROVED && review.Decision != common.STATUS_REFUSED {
+		return "", ErrInvalidDecision
+	}
+
+	stages, err := FindWorkflowStages(tx, ia.WorkflowID)
+	if err != nil {
+		return "", err
+	}
+
+	owners, err := applyOwners(tx, ia)
+	if err != nil {
+		return "", err
+	}
+
+	var current [][]string // reviewers of the stages running in parallel
+	var quorums []int32
+	isReviewer := false
+	for _, stage := range stages {
+		if stage.Seq != ia.Stage {
+			continue
+		}
+		var reviewers []string
+		for _, uid := range stage.ReviewerList() {
+			if resolved, ok := owners[uid]; ok {
+				reviewers = append(reviewers, resolved...)
+			} else {
+				reviewers = append(reviewers, uid)
+			}
+		}
+		for _, uid := range reviewers {
+			if uid == review.Reviewer {
+				isReviewer = true
+			}
+		}
+		current = append(current, reviewers)
+		quorums = append(quorums, stage.Quorum)
+	}
+	if !isReviewer {
+		return "", ErrNotStageReviewer
+	}
+
+	reviews, err := FindInfraApplyReviews(tx, ia.ID)
+	if err != nil {
+		return "", err
+	}
+
+	approvals := make(map[string]bool)
+	for _, r := range reviews {
+		if r.Stage != ia.Stage {
+			continue
+		}
+		if r.Reviewer == review.Reviewer {
+			return "", ErrAlreadyReviewed
+		}
+		if r.Decision == common.STATUS_APPROVED {
+			approvals[r.Reviewer] = true
+		}
+	}
+
+	review.Stage = ia.Stage
+	if err := common.AddOne(tx, review); err != nil {
+		return "", err
+	}
+
+	// a single refusal refuses the apply
+	if review.Decision == common.STATUS_REFUSED {
+		if err := UpdateInfraApply(tx, ia, updater); err != nil {
+			return "", err
+		}
+		return common.STATUS_REFUSED, nil
+	}
+
+	approvals[review.Reviewer] = true
+	for i, reviewers := range current {
+		var n int32
+		for _, uid := range reviewers {
+			if approvals[uid] {
+				n++
+			}
+		}
+		quorum := quorums[i]
+		if quorum <= 0 {
+			quorum = 1
+		}
+		if n < quorum {
+			return common.STATUS_INIT, nil
+		}
+	}
+
+	next, ok, err := NextWorkflowStage(tx, ia.WorkflowID, ia.Stage)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return common.STATUS_INIT, UpdateInfraApply(tx, ia, map[string]interface{}{"stage": next})
+	}
+
+	if err := UpdateInfraApply(tx, ia, updater); err != nil {
+		return "", err
+	}
+	return common.STATUS_APPROVED, nil
+}
+
+// applyOwners resolves the owner placeholders of workflow reviewers for ia, a device or subject
+// missing from the inventory resolves to nobody
+func applyOwners(mysqlCli *gorm.DB, ia *model.InfraApply) (map[string][]string, error) {
+	owners := map[string][]string{
+		common.REVIEWER_DEVICE_OWNER:  nil,
+		common.REVIEWER_SUBJECT_OWNER: nil,
+	}
+
+	d, err := FindOneDevice(mysqlCli, ia.DeviceCode)
+	if err != nil {
+		return nil, err
+	}
+	if d != nil && d.Owner != "" {
+		owners[common.REVIEWER_DEVICE_OWNER] = []string{d.Owner}
+	}
+
+	sub, err := FindOneSubject(mysqlCli, ia.SubjectName)
+	if err != nil {
+		return nil, err
+	}
+	if sub != nil {
+		owners[common.REVIEWER_SUBJECT_OWNER] = sub.OwnerList()
+	}
+
+	return owners, nil
+}
+
+// FindWorkflow returns a page of workflows matching query
+func FindWorkflow(mysqlCli *gorm.DB, query map[string]interface{}, limit, offset int32) ([]model.Workflow, int, error) {
+	return common.Find[model.Workflow](mysqlCli, common.NewQuery().Match(query).Page(limit, offset))
+}
+
+// FindWorkflowByName returns the latest version of the named workflow, or nil if there is none
+func FindWorkflowByName(mysqlCli *gorm.DB, name string) (*model.Workflow, error) {
+	var wf model.Workflow
+	err := mysqlCli.Where("name = ?", name).Order("id DESC").First(&wf).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &wf, nil
+}
+
+// AuditReview adds the audit entry of a review moving apply id from status from to status to, in
+// the transaction of the review
+func AuditReview(tx *gorm.DB, id int32, reviewer, decision, from, to string) error {
+	detail, _ := json.Marshal(map[string]interface{}{
+		"decision": decision,
+		"from":     from,
+		"to":       to,
+	})
+	audit := model.AuditLog{
+		Actor:   reviewer,
+		Action:  common.AUDIT_REVIEW,
+		ApplyID: id,
+		Detail:  string(detail),
+	}
+	return common.AddOne(tx, &audit)
+}
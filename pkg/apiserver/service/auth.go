@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"strings"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/apiserver/replica"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// authenticate returns the uid and the tenant of the bearer token in an authorization header value,
+// the tenant is the default one for a token without tenant claim
+func authenticate(cfg *config.Config, authz string) (uid, tenant string, err error) {
+	if cfg.Identify.AuthSecret == "" {
+		// never accept tokens signed with an empty key
+		return "", "", errors.New("authentication is not configured")
+	}
+
+	splits := strings.SplitN(authz, " ", 2)
+	if len(splits) < 2 || splits[0] != _bearer {
+		return "", "", errors.New("bad authorization string")
+	}
+
+	uid, tenant, _, err = parseToken(splits[1], cfg.Identify.AuthSecret)
+	if err != nil {
+		return "", "", err
+	}
+	if tenant, err = checkTenant(cfg, tenant); err != nil {
+		return "", "", err
+	}
+	return uid, tenant, nil
+}
+
+// identify sets the uid and the tenant of a grpc call into md and returns the uid. A client
+// certificate of a service account comes first, then a bearer token. Callers with neither are
+// anonymous, "", in the default tenant. The uid and the tenant sent by a client are never trusted.
+func identify(ctx context.Context, cfg *config.Config, md metadata.MD) (string, error) {
+	var uid, tenant string
+	if acc := certAccount(ctx, cfg.TLS.ServiceAccounts); acc != nil {
+		uid, tenant = acc.Principal, acc.Tenant
+	} else if authz := md[_headerAuthz]; len(authz) > 0 && authz[0] != "" {
+		var err error
+		uid, tenant, err = authenticate(cfg, authz[0])
+		if err != nil {
+			return "", status.Errorf(codes.Unauthenticated, "authenticate failed: %v", err)
+		}
+	}
+
+	tenant, err := checkTenant(cfg, tenant)
+	if err != nil {
+		return "", status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	delete(md, _uid)
+	if uid != "" {
+		md[_uid] = []string{uid}
+	}
+	md[_tenant] = []string{tenant}
+	return uid, nil
+}
+
+// checkTenant fills the default tenant, and rejects the tenants missing from the config when it has any
+func checkTenant(cfg *config.Config, tenant string) (string, error) {
+	if tenant == "" {
+		tenant = cfg.Tenancy.Default
+	}
+	if tenant == "" {
+		tenant = common.TENANT_DEFAULT
+	}
+	if len(cfg.Tenancy.Tenants) > 0 && cfg.Tenant(tenant) == nil {
+		return "", errors.New("unknown tenant " + tenant)
+	}
+	return tenant, nil
+}
+
+// tenantOf returns the tenant of the call of ctx, set by identify
+func tenantOf(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tenant := md[_tenant]; len(tenant) > 0 {
+		return tenant[0]
+	}
+	return common.TENANT_DEFAULT
+}
+
+// dbOf returns the db scoped to the tenant of the call of ctx, the transaction of withTx in it
+func dbOf(ctx context.Context, env *config.Env) *gorm.DB {
+	if tx := common.TxFrom(ctx); tx != nil {
+		// begun by withTx on the db of the same tenant
+		return tx
+	}
+	return replica.WithPin(common.WithTenant(env.MysqlCli, tenantOf(ctx)), pinKey(ctx))
+}
+
+// readerOf returns the db the reads of the call of ctx go to, scoped to its tenant. It is a
+// replica unless the caller wrote lately, asked for the primary with the read-primary header, or
+// reads in the transaction of withTx. Writes go through dbOf.
+func readerOf(ctx context.Context, env *config.Env) *gorm.DB {
+	if common.TxFrom(ctx) != nil || readPrimary(ctx) {
+		return dbOf(ctx, env)
+	}
+	return common.WithTenant(env.Replicas.Reader(pinKey(ctx)), tenantOf(ctx))
+}
+
+// pinKey identifies the caller of ctx, its writes pin its reads to the primary
+func pinKey(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	uid := ""
+	if v := md[_uid]; len(v) > 0 {
+		uid = v[0]
+	}
+	return pinKeyOf(tenantOf(ctx), uid)
+}
+
+func pinKeyOf(tenant, uid string) string {
+	return tenant + "/" + uid
+}
+
+// readPrimary reports whether the caller asked to read the primary
+func readPrimary(ctx context.Context) bool {
+	return flagOf(ctx, _readPrimary)
+}
+
+// flagOf reports whether the metadata key of the call is 1 or true
+func flagOf(ctx context.Context, key string) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	v := md[key]
+	return len(v) > 0 && (v[0] == "1" || strings.EqualFold(v[0], "true"))
+}
+
+// isAdmin reports whether uid is one of the configured admins
+func isAdmin(cfg *config.Config, uid string) bool {
+	for _, admin := range cfg.Identify.Admins {
+		if uid != "" && uid == admin {
+			return true
+		}
+	}
+	return false
+}
+
+// isTenantAdmin reports whether uid is an admin of tenant, the global admins are admins of every tenant
+func isTenantAdmin(cfg *config.Config, tenant, uid string) bool {
+	if isAdmin(cfg, uid) {
+		return true
+	}
+	if tc := cfg.Tenant(tenant); tc != nil {
+		for _, admin := range tc.Admins {
+			if uid != "" && uid == admin {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkTenantAdmin limits the catalog changes to the admins of the tenant of ctx and the global
+// admins, everyone else is denied, the anonymous callers included
+func checkTenantAdmin(ctx context.Context, cfg *config.Config) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var uid string
+	if value := md[_uid]; len(value) > 0 {
+		uid = value[0]
+	}
+	if uid == "" {
+		return status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+	if !isTenantAdmin(cfg, tenantOf(ctx), uid) {
+		return status.Error(codes.PermissionDenied, "user has not permission")
+	}
+	return nil
+}
+
+// certAccount returns the service account of the verified client certificate of ctx, nil for
+// a caller without one or with a certificate missing from the accounts
+func certAccount(ctx context.Context, accounts []config.ServiceAccountCfg) *config.ServiceAccountCfg {
+	p, ok := peer.FromContext(ctx)
+	if !ok || len(accounts) == 0 {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return accountOf(info.State.VerifiedChains[0][0], accounts)
+}
+
+// accountOf matches the subject of cert by its full DN first and then its common name
+func accountOf(cert *x509.Certificate, accounts []config.ServiceAccountCfg) *config.ServiceAccountCfg {
+	dn := cert.Subject.String()
+	for i := range accounts {
+		if accounts[i].Subject == dn {
+			return &accounts[i]
+		}
+	}
+	for i := range accounts {
+		if cert.Subject.CommonName != "" && accounts[i].Subject == cert.Subject.CommonName {
+			return &accounts[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/model"
+
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	_cacheBypass = "cache-bypass"
+)
+
+var (
+	// the tables the cached replies are read from, their writes invalidate the replies
+	_applyTables   = []string{(&model.InfraApply{}).TableName(), (&model.Device{}).TableName()}
+	_deviceTables  = []string{(&model.Device{}).TableName()}
+	_subjectTables = []string{(&model.Subject{}).TableName()}
+)
+
+// cached returns the reply to req of the tenant of ctx from the cache, load reads it on a miss
+// and only its successful replies are kept. The key is built before load, so a write during
+// load leaves the reply under a key that is not used anymore. A reply is not kept either while
+// the replicas may miss the last write to tables, another caller would be served the stale one.
+// The cache is skipped when it is disabled, in the transaction of withTx, for the callers pinned
+// to the primary by their writes, and for those asking for fresh reads with the cache-bypass or
+// read-primary header.
+func cached[M any, PM interface {
+	*M
+	proto.Message
+}](ctx context.Context, env *config.Env, req proto.Message, ttl time.Duration, tables []string,
+	load func() (PM, error)) (PM, error) {
+	if env.Cache == nil || common.TxFrom(ctx) != nil || bypassCache(ctx) || readPrimary(ctx) ||
+		env.Replicas.Pinned(pinKey(ctx)) {
+		return load()
+	}
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return load()
+	}
+	key := env.Cache.Key(tenantOf(ctx), tables, proto.MessageName(req), string(body))
+	if value, ok := env.Cache.Get(key); ok {
+		reply := PM(new(M))
+		if err := proto.Unmarshal(value, reply); err == nil {
+			return reply, nil
+		}
+	}
+
+	reply, err := load()
+	if err != nil {
+		return reply, err
+	}
+	if env.Replicas.Behind(env.Cache.Written(tenantOf(ctx), tables)) {
+		return reply, nil
+	}
+	if value, err := proto.Marshal(reply); err == nil {
+		env.Cache.Set(key, value, ttl)
+	}
+	return reply, nil
+}
+
+// bypassCache reports whether the caller asked not to read the cache
+func bypassCache(ctx context.Context) bool {
+	return flagOf(ctx, _cacheBypass)
+}
+
+// detailTTL is how long the reply of a lookup by id or name is cached
+func detailTTL(cfg *config.Config) time.Duration {
+	if cfg.Cache.DetailTTL > 0 {
+		return cfg.Cache.DetailTTL
+	}
+	return common.CACHE_DETAIL_TTL
+}
+
+// listTTL is how long a list reply is cached
+func listTTL(cfg *config.Config) time.Duration {
+	if cfg.Cache.ListTTL > 0 {
+		return cfg.Cache.ListTTL
+	}
+	return common.CACHE_LIST_TTL
+}
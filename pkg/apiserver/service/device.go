@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/model"
+
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeviceServiceV1 is the grpc service of the device inventory
+type DeviceServiceV1 struct {
+	env *config.Env
+}
+
+func validDeviceState(state string) bool {
+	switch state {
+	case common.DEVICE_ACTIVE, common.DEVICE_MAINTENANCE, common.DEVICE_DECOMMISSIONED:
+		return true
+	}
+	return false
+}
+
+func deviceDetail(d *model.Device) *v1.DeviceDetail {
+	return &v1.DeviceDetail{
+		ID:          d.ID,
+		Code:        d.Code,
+		Name:        d.Name,
+		Owner:       d.Owner,
+		Environment: d.Environment,
+		Tags:        d.TagList(),
+		State:       d.State,
+	}
+}
+
+// AddDevice adds a device to the inventory
+func (s *DeviceServiceV1) AddDevice(ctx context.Context, in *v1.AddDeviceReq) (*v1.AddDeviceReply, error) {
+	ret := v1.AddDeviceReply{}
+	if err := checkTenantAdmin(ctx, s.env.Cfg); err != nil {
+		return &ret, err
+	}
+	if in.Code == "" {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(code)")
+	}
+
+	d := model.Device{
+		Code:        in.Code,
+		Name:        in.Name,
+		Owner:       in.Owner,
+		Environment: in.Environment,
+		Tags:        strings.Join(in.Tags, ","),
+		State:       in.State,
+	}
+	if d.State == "" {
+		d.State = common.DEVICE_ACTIVE
+	}
+	if !validDeviceState(d.State) {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(state)")
+	}
+
+	exist, err := server.FindOneDevice(dbOf(ctx, s.env), in.Code)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if exist != nil {
+		return &ret, status.Error(codes.AlreadyExists, "device already exists")
+	}
+
+	if err := common.AddOne(dbOf(ctx, s.env), &d); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "insert db err")
+	}
+
+	return &v1.AddDeviceReply{Result: common.RESP_SUCCESS, ID: d.ID}, nil
+}
+
+// UpdateDevice changes the non empty fields of a device
+func (s *DeviceServiceV1) UpdateDevice(ctx context.Context, in *v1.UpdateDeviceReq) (*v1.UpdateDeviceReply, error) {
+	ret := v1.UpdateDeviceReply{}
+	if err := checkTenantAdmin(ctx, s.env.Cfg); err != nil {
+		return &ret, err
+	}
+	d, err := server.FindOneDevice(dbOf(ctx, s.env), in.Code)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if d == nil {
+		return &ret, status.Error(codes.NotFound, "empty result found")
+	}
+
+	updater := make(map[string]interface{})
+	if in.Name != "" {
+		updater["name"] = in.Name
+	}
+	if in.Owner != "" {
+		updater["owner"] = in.Owner
+	}
+	if in.Environment != "" {
+		updater["environment"] = in.Environment
+	}
+	if in.ClearTags {
+		updater["tags"] = ""
+	} else if len(in.Tags) > 0 {
+		updater["tags"] = strings.Join(in.Tags, ",")
+	}
+	if in.State != "" {
+		if !validDeviceState(in.State) {
+			return &ret, status.Error(codes.InvalidArgument, "invalid param(state)")
+		}
+		updater["state"] = in.State
+	}
+	if len(updater) == 0 {
+		return &v1.UpdateDeviceReply{Result: common.RESP_SUCCESS}, nil
+	}
+
+	if err := server.UpdateDevice(dbOf(ctx, s.env), d, updater); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "update db err")
+	}
+
+	return &v1.UpdateDeviceReply{Result: common.RESP_SUCCESS}, nil
+}
+
+// GetDevice returns the device with code
+func (s *DeviceServiceV1) GetDevice(ctx context.Context, in *v1.GetDeviceReq) (*v1.DeviceDetail, error) {
+	return cached(ctx, s.env, in, detailTTL(s.env.Cfg), _deviceTables, func() (*v1.DeviceDetail, error) {
+		return s.getDevice(ctx, in)
+	})
+}
+
+// getDevice reads the reply of GetDevice on a miss of the cache
+func (s *DeviceServiceV1) getDevice(ctx context.Context, in *v1.GetDeviceReq) (*v1.DeviceDetail, error) {
+	d, err := server.FindOneDevice(readerOf(ctx, s.env), in.Code)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if d == nil {
+		return nil, status.Error(codes.NotFound, "empty result found")
+	}
+
+	return deviceDetail(d), nil
+}
+
+// ListDevice lists devices, search matches the code or name
+func (s *DeviceServiceV1) ListDevice(ctx context.Context, in *v1.ListDeviceReq) (*v1.ListDeviceReply, error) {
+	reqPageIdx, reqPageSize := pageOf(in.PageIdx, in.PageSize)
+	if reqPageSize < 0 || reqPageSize > common.PAGE_SIZE {
+		return nil, status.Error(codes.InvalidArgument, "invalid param(pageSize)")
+	}
+	pageIdx, pageSize := reqPageIdx-1, reqPageSize
+	var limit, offset int32 = pageSize, pageSize * pageIdx
+	query := make(map[string]interface{})
+	if in.Owner != "" {
+		query["owner"] = in.Owner
+	}
+	if in.Environment != "" {
+		query["environment"] = in.Environment
+	}
+	if in.State != "" {
+		query["state"] = in.State
+	}
+
+	res, total, err := server.FindDeviceLikePattern(readerOf(ctx, s.env), query, in.Search, in.Tag, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := v1.ListDeviceReply{}
+	for i := range res {
+		ret.Record = append(ret.Record, deviceDetail(&res[i]))
+	}
+	ret.Page = &v1.ModelPage{PageSize: pageSize, PageIdx: pageIdx + 1, Total: int32(total)}
+	ret.Exhausted = (limit + offset) >= int32(total)
+	return &ret, nil
+}
+
+// DelDevice deletes a device, devices still referenced by applies should be decommissioned instead
+func (s *DeviceServiceV1) DelDevice(ctx context.Context, in *v1.DelDeviceReq) (*v1.DelDeviceReply, error) {
+	ret := v1.DelDeviceReply{}
+	if err := checkTenantAdmin(ctx, s.env.Cfg); err != nil {
+		return &ret, err
+	}
+	d, err := server.FindOneDevice(dbOf(ctx, s.env), in.Code)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if d == nil {
+		return &ret, status.Error(codes.NotFound, "empty result found")
+	}
+
+	if err := server.DeleteDevice(dbOf(ctx, s.env), d); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "delete db err")
+	}
+
+	return &v1.DelDeviceReply{Result: common.RESP_SUCCESS}, nil
+}
+
+// checkDevice rejects applies for devices that are unknown or decommissioned
+func checkDevice(ctx context.Context, env *config.Env, code string) error {
+	d, err := server.FindOneDevice(dbOf(ctx, env), code)
+	if err != nil {
+		logger.Errorf("server err: %v", err)
+		return status.Error(codes.Internal, "query db err")
+	}
+
+	if d == nil {
+		if env.Cfg.Device.AllowUnknown {
+			return nil
+		}
+		return status.Errorf(codes.InvalidArgument, "unknown device %s", code)
+	}
+	if d.State == common.DEVICE_DECOMMISSIONED {
+		return status.Errorf(codes.FailedPrecondition, "device %s is decommissioned", code)
+	}
+
+	return nil
+}
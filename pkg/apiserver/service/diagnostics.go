@@ -0,0 +1,211 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	rpprof "runtime/pprof"
+	"runtime/trace"
+	"strconv"
+	"time"
+
+	"big-infra/pkg/apiserver/cache"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+)
+
+var _startTime = time.Now()
+
+// NewDiagnosticsHandler returns the handler of the diagnostics listener, every path is limited to admins.
+//
+//	GET /debug/pprof/                    net/http/pprof index and named profiles
+//	GET /debug/capture/cpu?seconds=N     cpu profile of the next N seconds
+//	GET /debug/capture/trace?seconds=N   execution trace of the next N seconds
+//	GET /debug/goroutines                stacks of all goroutines
+//	GET /debug/runtime                   runtime and gc stats as json
+//	GET /debug/cache                     hits, misses and entries of the read cache as json
+func NewDiagnosticsHandler(env *config.Env) http.Handler {
+	// the cpu profiler and the tracer are process wide, so one capture runs at a time
+	capturing := make(chan struct{}, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/profile", captureHandler(capturing, captureCPU))
+	mux.HandleFunc("/debug/pprof/trace", captureHandler(capturing, captureTrace))
+	mux.HandleFunc("/debug/capture/cpu", captureHandler(capturing, captureCPU))
+	mux.HandleFunc("/debug/capture/trace", captureHandler(capturing, captureTrace))
+	mux.HandleFunc("/debug/goroutines", goroutineDump)
+	mux.HandleFunc("/debug/runtime", runtimeStats)
+	mux.HandleFunc("/debug/cache", cacheStats(env))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uid, _, err := authenticate(env.Cfg, r.Header.Get("Authorization"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if !isAdmin(env.Cfg, uid) {
+			http.Error(w, "user has not permission", http.StatusForbidden)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+type captureFunc func(w http.ResponseWriter, d time.Duration) error
+
+// captureHandler runs capture for the seconds of the request, up to CAPTURE_MAX_SECONDS
+func captureHandler(capturing chan struct{}, capture captureFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sec := int64(common.CAPTURE_SECONDS)
+		if s := r.URL.Query().Get("seconds"); s != "" {
+			var err error
+			sec, err = strconv.ParseInt(s, 10, 64)
+			if err != nil || sec <= 0 || sec > common.CAPTURE_MAX_SECONDS {
+				http.Error(w, fmt.Sprintf("invalid param(seconds), 1 to %d", common.CAPTURE_MAX_SECONDS), http.StatusBadRequest)
+				return
+			}
+		}
+
+		select {
+		case capturing <- struct{}{}:
+			defer func() { <-capturing }()
+		default:
+			http.Error(w, "another capture is running", http.StatusConflict)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if err := capture(w, time.Duration(sec)*time.Second); err != nil {
+			// nothing is written when starting fails
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+func captureCPU(w http.ResponseWriter, d time.Duration) error {
+	w.Header().Set("Content-Disposition", `attachment; filename="cpu.prof"`)
+	if err := rpprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+	time.Sleep(d)
+	rpprof.StopCPUProfile()
+	return nil
+}
+
+func captureTrace(w http.ResponseWriter, d time.Duration) error {
+	w.Header().Set("Content-Disposition", `attachment; filename="trace.out"`)
+	if err := trace.Start(w); err != nil {
+		return err
+	}
+	time.Sleep(d)
+	trace.Stop()
+	return nil
+}
+
+func goroutineDump(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_ = rpprof.Lookup("goroutine").WriteTo(w, 2)
+}
+
+type gcStats struct {
+	NumGC         int64     `json:"numGC"`
+	LastGC        time.Time `json:"lastGC"`
+	PauseTotal    string    `json:"pauseTotal"`
+	RecentPauses  []string  `json:"recentPauses"`
+	NextGCBytes   uint64    `json:"nextGCBytes"`
+	GCCPUFraction float64   `json:"gcCPUFraction"`
+	ForcedGCs     uint32    `json:"forcedGCs"`
+}
+
+type runtimeInfo struct {
+	GoVersion    string  `json:"goVersion"`
+	Uptime       string  `json:"uptime"`
+	NumCPU       int     `json:"numCPU"`
+	GoMaxProcs   int     `json:"goMaxProcs"`
+	NumGoroutine int     `json:"numGoroutine"`
+	NumCgoCall   int64   `json:"numCgoCall"`
+	HeapAlloc    uint64  `json:"heapAlloc"`
+	HeapInuse    uint64  `json:"heapInuse"`
+	HeapObjects  uint64  `json:"heapObjects"`
+	HeapSys      uint64  `json:"heapSys"`
+	StackInuse   uint64  `json:"stackInuse"`
+	Sys          uint64  `json:"sys"`
+	TotalAlloc   uint64  `json:"totalAlloc"`
+	Mallocs      uint64  `json:"mallocs"`
+	Frees        uint64  `json:"frees"`
+	GC           gcStats `json:"gc"`
+}
+
+func runtimeStats(w http.ResponseWriter, r *http.Request) {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	var gs debug.GCStats
+	gs.Pause = make([]time.Duration, 0, 10)
+	debug.ReadGCStats(&gs)
+	pauses := make([]string, 0, len(gs.Pause))
+	for i, p := range gs.Pause {
+		if i == 10 {
+			break
+		}
+		pauses = append(pauses, p.String())
+	}
+
+	info := runtimeInfo{
+		GoVersion:    runtime.Version(),
+		Uptime:       time.Since(_startTime).Round(time.Second).String(),
+		NumCPU:       runtime.NumCPU(),
+		GoMaxProcs:   runtime.GOMAXPROCS(0),
+		NumGoroutine: runtime.NumGoroutine(),
+		NumCgoCall:   runtime.NumCgoCall(),
+		HeapAlloc:    ms.HeapAlloc,
+		HeapInuse:    ms.HeapInuse,
+		HeapObjects:  ms.HeapObjects,
+		HeapSys:      ms.HeapSys,
+		StackInuse:   ms.StackInuse,
+		Sys:          ms.Sys,
+		TotalAlloc:   ms.TotalAlloc,
+		Mallocs:      ms.Mallocs,
+		Frees:        ms.Frees,
+		GC: gcStats{
+			NumGC:         gs.NumGC,
+			LastGC:        gs.LastGC,
+			PauseTotal:    gs.PauseTotal.String(),
+			RecentPauses:  pauses,
+			NextGCBytes:   ms.NextGC,
+			GCCPUFraction: ms.GCCPUFraction,
+			ForcedGCs:     ms.NumForcedGC,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(info)
+}
+
+type cacheInfo struct {
+	Enabled bool `json:"enabled"`
+	cache.Stats
+	HitRatio float64 `json:"hitRatio"`
+}
+
+// cacheStats writes the counters of the read cache
+func cacheStats(env *config.Env) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		info := cacheInfo{Enabled: env.Cache != nil}
+		if info.Enabled {
+			info.Stats = env.Cache.Stats()
+			if lookups := info.Hits + info.Misses; lookups > 0 {
+				info.HitRatio = float64(info.Hits) / float64(lookups)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(info)
+	}
+}
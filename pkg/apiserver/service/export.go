@@ -0,0 +1,186 @@
+package service
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/apiserver/util"
+	"big-infra/pkg/model"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	_exportFlushRows = 500
+	_exportTimeFmt   = "2006-01-02 15:04:05"
+)
+
+var (
+	_exportHeader = []string{"ID", "DeviceCode", "Applyer", "Status", "SubjectName", "ReviewId",
+		"ExpireTM", "ReviewTM", "Comment", "WorkflowID", "Stage", "PolicyRule"}
+)
+
+// rowWriter is a csv or xlsx writer
+type rowWriter interface {
+	Write(row []string) error
+	Flush() error
+	Close() error
+}
+
+type csvRowWriter struct {
+	*csv.Writer
+}
+
+func (c csvRowWriter) Flush() error {
+	c.Writer.Flush()
+	return c.Writer.Error()
+}
+
+func (c csvRowWriter) Close() error {
+	return c.Flush()
+}
+
+// NewExportHandler returns the http handler that streams the infra applies as csv or xlsx.
+// It takes the same filters as ListInfraApply, and is limited to admins.
+//
+//	GET /v1/export/applies?format=csv|xlsx&search=<subject>&status=&applyer=&deviceCode=&extendsID=&tz=<IANA zone>
+func NewExportHandler(env *config.Env) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		uid, tenant, err := authenticate(env.Cfg, r.Header.Get("Authorization"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if !isTenantAdmin(env.Cfg, tenant, uid) {
+			http.Error(w, "user has not permission", http.StatusForbidden)
+			return
+		}
+
+		params := r.URL.Query()
+		loc := util.Location
+		if tz := params.Get("tz"); tz != "" {
+			if loc, err = time.LoadLocation(tz); err != nil {
+				http.Error(w, "invalid param(tz)", http.StatusBadRequest)
+				return
+			}
+		}
+
+		format := params.Get("format")
+		if format == "" {
+			format = "csv"
+		}
+		if format != "csv" && format != "xlsx" {
+			http.Error(w, "invalid param(format)", http.StatusBadRequest)
+			return
+		}
+
+		var extendsID int64
+		if v := params.Get("extendsID"); v != "" {
+			if extendsID, err = strconv.ParseInt(v, 10, 32); err != nil {
+				http.Error(w, "invalid param(extendsID)", http.StatusBadRequest)
+				return
+			}
+		}
+		query := infraApplyQuery(int32(extendsID), params.Get("status"), params.Get("applyer"), params.Get("deviceCode"))
+
+		search := make(map[string]interface{})
+		if s := params.Get("search"); s != "" {
+			search["subject_name"] = s
+		}
+
+		db := common.WithTenant(env.Replicas.Reader(pinKeyOf(tenant, uid)), tenant)
+		rows, err := server.InfraApplyRowsLikePattern(db, query, search)
+		if err != nil {
+			logger.Errorf("server err: %v", err)
+			http.Error(w, "query db err", http.StatusInternalServerError)
+			return
+		}
+		defer rows.Close()
+
+		filename := fmt.Sprintf("applies-%s.%s", time.Now().In(loc).Format("20060102150405"), format)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+		var rw rowWriter
+		if format == "xlsx" {
+			w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+			rw, err = util.NewXLSXWriter(w, "applies")
+			if err != nil {
+				logger.Errorf("export err: %v", err)
+				return
+			}
+		} else {
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			rw = csvRowWriter{csv.NewWriter(w)}
+		}
+
+		flusher, _ := w.(http.Flusher)
+		n := 0
+		err = rw.Write(_exportHeader)
+		for err == nil && rows.Next() {
+			var ia model.InfraApply
+			if err = server.ScanInfraApply(db, rows, &ia); err != nil {
+				break
+			}
+			if err = rw.Write(exportRow(&ia, loc)); err != nil {
+				break
+			}
+
+			n++
+			if n%_exportFlushRows == 0 {
+				if err = rw.Flush(); err == nil && flusher != nil {
+					flusher.Flush()
+				}
+			}
+		}
+		if err == nil {
+			err = rows.Err()
+		}
+		if err != nil {
+			// the status is already sent, the client gets a truncated file
+			logger.Errorf("export by %s stopped after %d rows: %v", uid, n, err)
+			return
+		}
+
+		if err := rw.Close(); err != nil {
+			logger.Errorf("export err: %v", err)
+			return
+		}
+		logger.Infof("%s exported %d applies as %s", uid, n, format)
+	})
+}
+
+// exportRow formats an apply in loc, zero times are left empty
+func exportRow(ia *model.InfraApply, loc *time.Location) []string {
+	return []string{
+		strconv.Itoa(int(ia.ID)),
+		ia.DeviceCode,
+		ia.Applyer,
+		ia.Status,
+		ia.SubjectName,
+		ia.ReviewId,
+		formatTime(ia.ExpiresAt, loc),
+		formatTime(ia.ReviewedAt, loc),
+		ia.Comment,
+		strconv.Itoa(int(ia.WorkflowID)),
+		strconv.Itoa(int(ia.Stage)),
+		ia.PolicyRule,
+	}
+}
+
+func formatTime(t time.Time, loc *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(loc).Format(_exportTimeFmt)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/apiserver/util"
+	"big-infra/pkg/model"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExtendInfraApply asks for a later expiry of a grant. The extension is a new apply linked to the
+// grant by ExtendsID, it goes through the policy rules and workflow of the subject, and the grant
+// takes the new expiry once the extension is approved. An expired grant can be renewed the same way.
+// Only the applyer of the grant can extend it, the uid of the request is not trusted.
+func (s *InfraApplyServiceV1) ExtendInfraApply(ctx context.Context, in *v1.ExtendInfraApplyReq) (*v1.ExtendInfraApplyReply, error) {
+	ret := v1.ExtendInfraApplyReply{}
+	if in.ID == 0 || in.ExpireTM == "" {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(ID, expireTm)")
+	}
+	caller := s.GetUser(ctx)
+	if caller == "" {
+		return &ret, status.Error(codes.Unauthenticated, "extending a grant needs an authenticated caller")
+	}
+	expireTm, err := util.StrToTime(in.ExpireTM)
+	if err != nil {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(expireTm)")
+	}
+
+	grant, err := server.FindOneInfraApply(dbOf(ctx, s.env), map[string]interface{}{"id": in.ID})
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if grant == nil {
+		return &ret, status.Error(codes.NotFound, "empty result found")
+	}
+
+	if grant.Applyer != caller {
+		return &ret, status.Error(codes.PermissionDenied, "only the applyer can extend a grant")
+	}
+	if grant.ExtendsID != 0 {
+		return &ret, status.Errorf(codes.FailedPrecondition, "apply %d is an extension, extend grant %d instead",
+			grant.ID, grant.ExtendsID)
+	}
+	if grant.Status != common.STATUS_APPROVED && grant.Status != common.STATUS_EXPIRED {
+		return &ret, status.Error(codes.FailedPrecondition, "only approved or expired applies can be extended")
+	}
+	if grant.ExpiresAt.IsZero() {
+		return &ret, status.Error(codes.FailedPrecondition, "grant never expires")
+	}
+
+	now := time.Now()
+	if !expireTm.After(now) || !expireTm.After(grant.ExpiresAt) {
+		return &ret, status.Error(codes.InvalidArgument, "new expiry must be later than the current one")
+	}
+
+	if err := checkDevice(ctx, s.env, grant.DeviceCode); err != nil {
+		return &ret, err
+	}
+
+	sub, err := server.FindOneSubject(dbOf(ctx, s.env), grant.SubjectName)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if _, err := subjectExpiry(sub, s.env.Cfg.Tenant(tenantOf(ctx)), expireTm, now); err != nil {
+		return &ret, err
+	}
+
+	ext := model.InfraApply{
+		DeviceCode:  grant.DeviceCode,
+		Applyer:     grant.Applyer,
+		Status:      common.STATUS_INIT,
+		SubjectName: grant.SubjectName,
+		ExpiresAt:   expireTm,
+		Comment:     in.Comment,
+		ExtendsID:   grant.ID,
+	}
+	if err := s.routeInfraApply(ctx, &ext, sub, now); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+
+	err = s.withTx(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		// the grant is locked so that two extensions cannot both find no pending one
+		if _, err := server.LockInfraApply(tx, grant.ID); err != nil {
+			return err
+		}
+		pending, err := server.FindPendingExtension(tx, grant.ID)
+		if err != nil {
+			return err
+		}
+		if pending != nil {
+			return status.Errorf(codes.FailedPrecondition, "extension %d of the grant is waiting for review",
+				pending.ID)
+		}
+
+		ext.ID = 0 // set by a run rolled back by a deadlock
+		err = addRoutedInfraApply(tx, &ext)
+		if err == nil && ext.Status == common.STATUS_APPROVED {
+			err = server.ExtendGrant(tx, &ext, ext.ExpiresAt, ext.ReviewId)
+		}
+		return err
+	})
+	if err != nil {
+		return &ret, txErr(ctx, err, "insert db err")
+	}
+
+	return &v1.ExtendInfraApplyReply{
+		Result:     common.RESP_SUCCESS,
+		ID:         ext.ID,
+		Status:     ext.Status,
+		PolicyRule: ext.PolicyRule,
+	}, nil
+}
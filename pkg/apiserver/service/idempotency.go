@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/model"
+
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	_idempotencyKey = "idempotency-key"
+)
+
+var (
+	// _idempotentMethods are the mutating methods that honor the idempotency key
+	_idempotentMethods = map[string]struct{}{
+		"/InfraApply.INFRAAPPLY/AddInfraApply":         {},
+		"/InfraApply.INFRAAPPLY/UpdateInfraApply":      {},
+		"/InfraApply.INFRAAPPLY/BatchUpdateInfraApply": {},
+		"/InfraApply.INFRAAPPLY/ExtendInfraApply":      {},
+		"/InfraApply.INFRAAPPLY/DelInfraApply":         {},
+		"/InfraApply.v2.INFRAAPPLY/AddInfraApply":      {},
+		"/InfraApply.v2.INFRAAPPLY/UpdateInfraApply":   {},
+		"/InfraApply.v2.INFRAAPPLY/ExtendInfraApply":   {},
+	}
+)
+
+// idempotency is a server interceptor that replays the stored response when a mutating request
+// is retried with the same idempotency key, and rejects a key reused with a different request.
+// The keys are scoped to the authenticated caller, a key sent by another caller is not replayed.
+func (s *GrpcService) idempotency() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := _idempotentMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(_idempotencyKey)
+		if len(keys) == 0 || keys[0] == "" {
+			return handler(ctx, req)
+		}
+
+		key := keys[0]
+		if len(key) > common.IDEMPOTENCY_KEY_LEN {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key longer than %d", common.IDEMPOTENCY_KEY_LEN)
+		}
+
+		// the uid set by identify, the keys of anonymous callers are shared among them
+		var uid string
+		if value := md[_uid]; len(value) > 0 {
+			uid = value[0]
+		}
+
+		fingerprint, err := requestFingerprint(info.FullMethod, req)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		ttl := s.env.Cfg.Idempotency.TTL
+		if ttl <= 0 {
+			ttl = common.IDEMPOTENCY_TTL
+		}
+
+		rec := &model.IdempotencyKey{
+			Uid:         uid,
+			Key:         key,
+			Method:      info.FullMethod,
+			Fingerprint: fingerprint,
+			ExpiresAt:   time.Now().Add(ttl),
+		}
+		exist, claimed, err := server.ClaimIdempotencyKey(dbOf(ctx, s.env), rec)
+		if err != nil {
+			logger.Errorf("server err: %v", err)
+			return nil, status.Error(codes.Internal, "query db err")
+		}
+
+		if !claimed {
+			if exist.Fingerprint != fingerprint {
+				return nil, status.Error(codes.InvalidArgument, "idempotency key was used with a different request")
+			}
+			if exist.RespType == "" {
+				return nil, status.Error(codes.Aborted, "request with the same idempotency key is in progress")
+			}
+			return replayResponse(exist)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if rerr := server.ReleaseIdempotencyKey(dbOf(ctx, s.env), uid, key); rerr != nil {
+				logger.Errorf("release idempotency key %s err: %v", key, rerr)
+			}
+			return resp, err
+		}
+
+		msg := resp.(proto.Message)
+		body, merr := proto.Marshal(msg)
+		if merr == nil {
+			merr = server.SaveIdempotencyResponse(dbOf(ctx, s.env), uid, key, proto.MessageName(msg), body)
+		}
+		if merr != nil {
+			// the request is done, a later retry will be told it is in progress until the key expires
+			logger.Errorf("save idempotency key %s err: %v", key, merr)
+		}
+
+		return resp, nil
+	}
+}
+
+// requestFingerprint hashes the method together with the encoded request
+func requestFingerprint(method string, req interface{}) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", errors.New("request is not a proto message")
+	}
+
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// replayResponse decodes the response stored with an idempotency key
+func replayResponse(rec *model.IdempotencyKey) (interface{}, error) {
+	typ := proto.MessageType(rec.RespType)
+	if typ == nil {
+		return nil, status.Errorf(codes.Internal, "unknown stored response type %s", rec.RespType)
+	}
+
+	msg := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(rec.Response, msg); err != nil {
+		logger.Errorf("decode idempotency key %s err: %v", rec.Key, err)
+		return nil, status.Error(codes.Internal, "decode stored response err")
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/apiserver/util"
+	"big-infra/pkg/model"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImportInfraApply imports the rows streamed by the client in one transaction. Invalid rows
+// are reported with their line and skipped, with dryRun nothing is saved. Every saved row
+// writes an audit entry tagged with the batch ID of the import. Only the admins of the tenant can
+// import, and the rows are checked against the inventory and the maximum durations like the
+// applies of AddInfraApply.
+func (s *InfraApplyServiceV1) ImportInfraApply(stream v1.INFRAAPPLY_ImportInfraApplyServer) error {
+	ctx := stream.Context()
+	actor := s.GetUser(ctx)
+	if actor == "" {
+		return status.Error(codes.Unauthenticated, "importing applies needs an authenticated caller")
+	}
+	if !isTenantAdmin(s.env.Cfg, tenantOf(ctx), actor) {
+		return status.Error(codes.PermissionDenied, "user has not permission")
+	}
+	ret := v1.ImportInfraApplyReply{BatchID: uuid.New().String()}
+
+	opts, err := s.env.Cfg.MySQL.TxOptions()
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return status.Error(codes.Internal, "begin db transaction err")
+	}
+	// the rows are read from the stream once, the import cannot run again after a deadlock
+	opts.Retries = 0
+
+	var dryRun bool
+	err = common.WithTx(ctx, dbOf(ctx, s.env), opts, func(ctx context.Context, tx *gorm.DB) error {
+		var (
+			upsert bool
+			first  = true
+			now    = time.Now()
+		)
+		for {
+			in, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			if first {
+				dryRun, upsert, first = in.DryRun, in.Upsert, false
+			}
+			if in.Row == nil {
+				continue
+			}
+
+			ret.Total++
+			if ret.Total > common.IMPORT_ROWS {
+				return status.Errorf(codes.InvalidArgument, "too many rows, at most %d", common.IMPORT_ROWS)
+			}
+
+			line := in.Row.Line
+			if line == 0 {
+				line = ret.Total
+			}
+
+			created, err := s.importRow(ctx, tx, in.Row, upsert, actor, ret.BatchID, now)
+			if err != nil {
+				ret.Failed++
+				ret.Errors = append(ret.Errors, &v1.ImportRowError{Line: line, Error: err.Error()})
+				continue
+			}
+			if created {
+				ret.Created++
+			} else {
+				ret.Updated++
+			}
+		}
+
+		if dryRun {
+			return errRollback
+		}
+		return nil
+	})
+	if err != nil && err != errRollback {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return status.Error(codes.Internal, "commit db err")
+	}
+
+	logging.FromContext(ctx).Infof("import %s by %s: %d rows, %d created, %d updated, %d failed, dry run %v",
+		ret.BatchID, actor, ret.Total, ret.Created, ret.Updated, ret.Failed, dryRun)
+
+	ret.Result = common.RESP_SUCCESS
+	if ret.Failed > 0 {
+		ret.Result = common.RESP_FAILED
+	}
+	return stream.SendAndClose(&ret)
+}
+
+// importRow saves a row and its audit entry, it reports whether the apply was created or updated.
+// ctx carries the transaction tx of the import.
+func (s *InfraApplyServiceV1) importRow(ctx context.Context, tx *gorm.DB, row *v1.ImportInfraApplyRow,
+	upsert bool, actor, batchID string, now time.Time) (bool, error) {
+	ia := model.InfraApply{
+		DeviceCode:  row.DeviceCode,
+		Applyer:     row.Uid,
+		Status:      row.Status,
+		SubjectName: row.SubjectName,
+		ReviewId:    row.ReviewId,
+		Comment:     row.Comment,
+	}
+	if ia.Status == "" {
+		ia.Status = common.STATUS_APPROVED
+	}
+
+	var err error
+	if row.ExpireTM != "" {
+		if ia.ExpiresAt, err = util.StrToTime(row.ExpireTM); err != nil {
+			return false, errors.New("invalid param(expireTm)")
+		}
+	}
+	if row.ReviewTM != "" {
+		if ia.ReviewedAt, err = util.StrToTime(row.ReviewTM); err != nil {
+			return false, errors.New("invalid param(reviewTm)")
+		}
+	} else if ia.Status != common.STATUS_INIT {
+		ia.ReviewedAt = now
+	}
+
+	if err := server.ValidateInfraApply(&ia); err != nil {
+		return false, err
+	}
+
+	// the row is reported with the message of the status, not the whole error
+	if err := checkDevice(ctx, s.env, ia.DeviceCode); err != nil {
+		return false, errors.New(status.Convert(err).Message())
+	}
+	sub, err := server.FindOneSubject(tx, ia.SubjectName)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return false, errors.New("query db err")
+	}
+	ia.ExpiresAt, err = subjectExpiry(sub, s.env.Cfg.Tenant(tenantOf(ctx)), ia.ExpiresAt, now)
+	if err != nil {
+		return false, errors.New(status.Convert(err).Message())
+	}
+
+	exist, err := server.FindInfraApplyByNaturalKey(tx, ia.Applyer, ia.DeviceCode, ia.SubjectName)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return false, errors.New("query db err")
+	}
+
+	action := common.AUDIT_IMPORT_CREATE
+	if exist != nil {
+		if !upsert {
+			return false, fmt.Errorf("apply already exists (ID %d)", exist.ID)
+		}
+
+		updater := map[string]interface{}{
+			"status":     ia.Status,
+			"expires_at": ia.ExpiresAt,
+			"review_id":  ia.ReviewId,
+			"review_at":  ia.ReviewedAt,
+			"comment":    ia.Comment,
+		}
+		err = server.UpdateInfraApply(tx, exist, updater)
+		ia.ID = exist.ID
+		action = common.AUDIT_IMPORT_UPDATE
+	} else {
+		err = common.AddOne(tx, &ia)
+	}
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return false, errors.New("update db err")
+	}
+
+	detail, _ := json.Marshal(row)
+	audit := model.AuditLog{
+		BatchID: batchID,
+		Actor:   actor,
+		Action:  action,
+		ApplyID: ia.ID,
+		Detail:  string(detail),
+	}
+	if err := common.AddOne(tx, &audit); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return false, errors.New("insert audit err")
+	}
+
+	return exist == nil, nil
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/policy"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/apiserver/util"
+	"big-infra/pkg/model"
+
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReloadPolicy loads the policy rules from config and the rule table
+func (s *InfraApplyServiceV1) ReloadPolicy() error {
+	var rules []policy.Rule
+	for _, r := range s.env.Cfg.Policy.Rules {
+		rules = append(rules, policy.Rule{
+			Name:     r.Name,
+			Expr:     r.Expr,
+			Action:   r.Action,
+			Workflow: r.Workflow,
+			Priority: r.Priority,
+			Tenant:   r.Tenant,
+		})
+	}
+
+	res, err := server.FindPolicyRules(common.AllTenants(s.env.MysqlCli))
+	if err != nil {
+		return err
+	}
+	for _, r := range res {
+		rules = append(rules, policy.Rule{
+			Name:     r.Name,
+			Expr:     r.Expr,
+			Action:   r.Action,
+			Workflow: r.Workflow,
+			Priority: r.Priority,
+			Tenant:   r.TenantID,
+		})
+	}
+
+	if err := s.policy.Load(rules); err != nil {
+		return err
+	}
+
+	logger.Infof("loaded %d policy rules", len(rules))
+	return nil
+}
+
+// routeInfraApply runs the policy rules over a new apply. The apply is decided when a rule
+// approves or refuses it, otherwise it is attached to the workflow of the rule or of its subject.
+// A subject with a required workflow can only be refused by a rule, approving and routing rules
+// are ignored and the apply goes through the required workflow. sub is nil for subjects missing
+// from the catalog. Only the rules and the workflows of the tenant of ctx are used.
+func (s *InfraApplyServiceV1) routeInfraApply(ctx context.Context, ia *model.InfraApply, sub *model.Subject, now time.Time) error {
+	var duration time.Duration
+	if !ia.ExpiresAt.IsZero() {
+		duration = ia.ExpiresAt.Sub(now)
+	}
+
+	var risk, required string
+	if sub != nil {
+		risk, required = sub.RiskLevel, sub.Workflow
+	}
+
+	decision, _ := s.policy.Evaluate(policy.Input{
+		Tenant:     tenantOf(ctx),
+		Applyer:    ia.Applyer,
+		Subject:    ia.SubjectName,
+		DeviceCode: ia.DeviceCode,
+		Risk:       risk,
+		Duration:   duration,
+		Now:        now,
+	})
+	if decision != nil && required != "" && decision.Action != common.POLICY_REFUSE {
+		logger.Infof("policy rule %s ignored, subject %s requires workflow %s",
+			decision.Rule, ia.SubjectName, required)
+		decision = nil
+	}
+
+	var (
+		wf  *model.Workflow
+		err error
+	)
+	if decision != nil {
+		ia.PolicyRule = decision.Rule
+		switch decision.Action {
+		case common.POLICY_APPROVE, common.POLICY_REFUSE:
+			ia.Status = common.STATUS_APPROVED
+			if decision.Action == common.POLICY_REFUSE {
+				ia.Status = common.STATUS_REFUSED
+			}
+			ia.ReviewId = common.POLICY_REVIEWER_PREFIX + decision.Rule
+			ia.ReviewedAt = now
+			return nil
+		case common.POLICY_WORKFLOW:
+			wf, err = server.FindWorkflowByName(dbOf(ctx, s.env), decision.Workflow)
+			if err != nil {
+				return err
+			}
+			if wf == nil {
+				logger.Warnf("policy rule %s routes to unknown workflow %s, use the subject workflow",
+					decision.Rule, decision.Workflow)
+			}
+		}
+	}
+
+	if wf == nil && required != "" {
+		wf, err = server.FindWorkflowByName(dbOf(ctx, s.env), required)
+		if err != nil {
+			return err
+		}
+		if wf == nil {
+			logger.Warnf("subject %s requires unknown workflow %s", ia.SubjectName, required)
+		}
+	}
+	if wf == nil {
+		wf, err = server.FindSubjectWorkflow(dbOf(ctx, s.env), ia.SubjectName)
+		if err != nil {
+			return err
+		}
+	}
+	if wf == nil {
+		return nil
+	}
+
+	first, ok, err := server.NextWorkflowStage(dbOf(ctx, s.env), wf.ID, 0)
+	if err != nil {
+		return err
+	}
+	if ok {
+		ia.WorkflowID, ia.Stage = wf.ID, first
+	}
+	return nil
+}
+
+// SimulatePolicy evaluates the policy rules for an apply without saving anything
+func (s *InfraApplyServiceV1) SimulatePolicy(ctx context.Context, in *v1.SimulatePolicyReq) (*v1.SimulatePolicyReply, error) {
+	ret := v1.SimulatePolicyReply{}
+	now := time.Now()
+	if in.EvalTM != "" {
+		evalTm, err := util.StrToTime(in.EvalTM)
+		if err != nil {
+			return &ret, status.Error(codes.InvalidArgument, "invalid param(evalTm)")
+		}
+		now = evalTm
+	}
+
+	var duration time.Duration
+	if in.ExpireTM != "" {
+		expireTm, err := util.StrToTime(in.ExpireTM)
+		if err != nil {
+			return &ret, status.Error(codes.InvalidArgument, "invalid param(expireTm)")
+		}
+		duration = expireTm.Sub(now)
+	}
+
+	sub, err := server.FindOneSubject(dbOf(ctx, s.env), in.SubjectName)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	var risk string
+	if sub != nil {
+		risk = sub.RiskLevel
+	}
+
+	decision, results := s.policy.Evaluate(policy.Input{
+		Tenant:     tenantOf(ctx),
+		Applyer:    in.Uid,
+		Subject:    in.SubjectName,
+		DeviceCode: in.DeviceCode,
+		Risk:       risk,
+		Duration:   duration,
+		Now:        now,
+	})
+
+	if decision != nil {
+		ret.Rule, ret.Action, ret.Workflow = decision.Rule, decision.Action, decision.Workflow
+	}
+	for _, r := range results {
+		rec := v1.PolicyRuleResult{Name: r.Rule, Matched: r.Matched}
+		if r.Err != nil {
+			rec.Error = r.Err.Error()
+		}
+		ret.Results = append(ret.Results, &rec)
+	}
+	return &ret, nil
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/apiserver/notify"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/model"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// Reminder tells applyers that their grants are about to expire. Each approved apply is reminded
+// once per window, the claim in t_notification keeps replicas and restarts from sending it twice.
+type Reminder struct {
+	env      *config.Env
+	interval time.Duration
+	windows  []time.Duration // ascending
+	channels []notify.Channel
+	tenants  map[string][]notify.Channel // tenants with their own channels
+}
+
+// NewReminder news a Reminder from the Reminder config
+func NewReminder(env *config.Env) (*Reminder, error) {
+	cfg := env.Cfg.Reminder
+	r := &Reminder{env: env, interval: cfg.Interval}
+	if r.interval <= 0 {
+		r.interval = common.REMINDER_INTERVAL
+	}
+
+	r.windows = append(r.windows, cfg.Windows...)
+	if len(r.windows) == 0 {
+		r.windows = append(r.windows, common.REMINDER_WINDOWS...)
+	}
+	sort.Slice(r.windows, func(i, j int) bool { return r.windows[i] < r.windows[j] })
+	if r.windows[0] <= 0 {
+		return nil, fmt.Errorf("reminder window must be positive, got %v", r.windows[0])
+	}
+
+	channels := cfg.Channels
+	if len(channels) == 0 {
+		channels = []config.NotifyChannelCfg{{Type: common.NOTIFY_LOG}}
+	}
+	var err error
+	if r.channels, err = newChannels(channels); err != nil {
+		return nil, err
+	}
+
+	r.tenants = make(map[string][]notify.Channel)
+	for _, tc := range env.Cfg.Tenancy.Tenants {
+		if len(tc.Channels) == 0 {
+			continue
+		}
+		if r.tenants[tc.ID], err = newChannels(tc.Channels); err != nil {
+			return nil, fmt.Errorf("tenant %s: %v", tc.ID, err)
+		}
+	}
+
+	return r, nil
+}
+
+func newChannels(cfgs []config.NotifyChannelCfg) ([]notify.Channel, error) {
+	var channels []notify.Channel
+	for _, c := range cfgs {
+		ch, err := notify.New(c)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, ch)
+	}
+	return channels, nil
+}
+
+// channelsOf returns the channels of tenant, the default ones when it has none
+func (r *Reminder) channelsOf(tenant string) []notify.Channel {
+	if channels, ok := r.tenants[tenant]; ok {
+		return channels
+	}
+	return r.channels
+}
+
+// Run checks for expiring grants every interval until ctx is done
+func (r *Reminder) Run(ctx context.Context) {
+	logger.Infof("expiry reminder started, windows %v", r.windows)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := r.RunOnce(ctx, time.Now()); err != nil {
+			logger.Errorf("expiry reminder err: %v", err)
+		} else if n > 0 {
+			logger.Infof("expiry reminder sent %d reminders", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce reminds the applies of every tenant expiring within the largest window and returns how
+// many reminders were sent. An apply is only reminded for the smallest window it is in, so a reminder missed
+// while the job was down is not sent late on top of the next one.
+func (r *Reminder) RunOnce(ctx context.Context, now time.Time) (int, error) {
+	var sent int
+	var afterID int32
+	until := now.Add(r.windows[len(r.windows)-1])
+	for {
+		res, err := server.FindExpiringInfraApply(common.AllTenants(r.env.MysqlCli), now, until, afterID, common.PAGE_SIZE)
+		if err != nil {
+			return sent, err
+		}
+
+		for i := range res {
+			ok, err := r.remind(ctx, &res[i], now)
+			if err != nil {
+				return sent, err
+			}
+			if ok {
+				sent++
+			}
+		}
+
+		if len(res) < common.PAGE_SIZE {
+			return sent, nil
+		}
+		afterID = res[len(res)-1].ID
+	}
+}
+
+// remind sends the reminder of ia for its current window, unless it was already claimed
+func (r *Reminder) remind(ctx context.Context, ia *model.InfraApply, now time.Time) (bool, error) {
+	left := ia.ExpiresAt.Sub(now)
+	var window time.Duration
+	for _, w := range r.windows {
+		if left <= w {
+			window = w
+			break
+		}
+	}
+	if window == 0 {
+		return false, nil
+	}
+
+	rec := model.Notification{
+		Key:       server.NotificationKey(ia.ID, window, ia.ExpiresAt),
+		ApplyID:   ia.ID,
+		Applyer:   ia.Applyer,
+		Window:    int64(window / time.Second),
+		ExpiresAt: ia.ExpiresAt,
+		Status:    common.NOTIFY_SENDING,
+	}
+	db := common.WithTenant(r.env.MysqlCli, ia.TenantID)
+	claimed, err := server.ClaimNotification(db, &rec)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	msg := notify.Message{
+		Tenant:     ia.TenantID,
+		ApplyID:    ia.ID,
+		Applyer:    ia.Applyer,
+		Subject:    ia.SubjectName,
+		DeviceCode: ia.DeviceCode,
+		ExpiresAt:  ia.ExpiresAt,
+		Window:     window,
+	}
+	channels := r.channelsOf(ia.TenantID)
+	var failed []string
+	for _, ch := range channels {
+		if err := ch.Send(ctx, &msg); err != nil {
+			logger.Warnf("remind apply %d through %s: %v", ia.ID, ch.Name(), err)
+			failed = append(failed, fmt.Sprintf("%s: %v", ch.Name(), err))
+		}
+	}
+
+	// nothing got through, drop the claim so the next run tries again
+	if len(failed) == len(channels) {
+		return false, server.ReleaseNotification(db, rec.Key)
+	}
+
+	err = server.UpdateNotification(db, rec.Key, map[string]interface{}{
+		"status": common.NOTIFY_SENT,
+		"error":  strings.Join(failed, "; "),
+	})
+	return true, err
+}
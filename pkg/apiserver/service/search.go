@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/search"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchInfraApply looks the query up in the FULLTEXT index of the applies, see server.SearchColumns
+func (s *InfraApplyServiceV1) SearchInfraApply(ctx context.Context, in *v1.SearchInfraApplyReq) (*v1.SearchInfraApplyReply, error) {
+	ret := v1.SearchInfraApplyReply{}
+	q, err := search.Parse(in.Query, s.env.Cfg.Search.MinTokenLen)
+	if err != nil {
+		return &ret, status.Errorf(codes.InvalidArgument, "invalid param(query): %v", err)
+	}
+
+	reqPageIdx, reqPageSize := pageOf(in.PageIdx, in.PageSize)
+	if reqPageSize < 0 || reqPageSize > common.PAGE_SIZE {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(pageSize)")
+	}
+	pageIdx, pageSize := reqPageIdx-1, reqPageSize
+	var limit, offset int32 = pageSize, pageSize * pageIdx
+	query := infraApplyQuery(0, in.Status, in.Applyer, in.DeviceCode)
+
+	hits, total, err := server.SearchInfraApply(readerOf(ctx, s.env), query, q.Boolean(), limit, offset)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+
+	applies := make([]model.InfraApply, 0, len(hits))
+	for _, h := range hits {
+		applies = append(applies, h.InfraApply)
+	}
+	records, err := s.infraApplyDetails(ctx, applies)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+
+	for i, h := range hits {
+		ret.Hit = append(ret.Hit, &v1.SearchInfraApplyHit{
+			Apply:      records[i],
+			Score:      h.Score,
+			Highlights: highlights(q, &h.InfraApply),
+		})
+	}
+	ret.Page = &v1.ModelPage{PageSize: pageSize, PageIdx: pageIdx + 1, Total: int32(total)}
+	ret.Exhausted = limit+offset >= int32(total)
+	return &ret, nil
+}
+
+// highlights returns the searched fields of ia matching q, keyed by their json name
+func highlights(q *search.Query, ia *model.InfraApply) map[string]string {
+	res := make(map[string]string)
+	for name, value := range map[string]string{
+		"subjectName": ia.SubjectName,
+		"applyer":     ia.Applyer,
+		"deviceCode":  ia.DeviceCode,
+		"comment":     ia.Comment,
+	} {
+		if h, ok := q.Highlight(value); ok {
+			res[name] = h
+		}
+	}
+	return res
+}
@@ -10,17 +10,24 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strconv"
 	"syscall"
 	"time"
 
 	v1 "big-infra/pkg/apiserver/api/v1"
+	v2 "big-infra/pkg/apiserver/api/v2"
 	"big-infra/pkg/apiserver/common"
 	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/policy"
 	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/model"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
 	logger "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/keepalive"
@@ -34,9 +41,11 @@ const (
 	_abortIndex  int8 = math.MaxInt8 / 2
 	_traceID          = "trace_id"
 	_uid              = "uid"
+	_tenant           = "tenant"
 	_token            = "token"
 	_headerAuthz      = "authorization"
 	_bearer           = "Bearer"
+	_readPrimary      = "read-primary"
 )
 
 var (
@@ -46,19 +55,23 @@ var (
 
 // TuPam grpc service struct
 type InfraApplyServiceV1 struct {
-	env *config.Env
-	uid string
+	env    *config.Env
+	uid    string
+	policy *policy.Engine
 }
 
 // GrpcService is the grpc server and its configurations.
 type GrpcService struct {
 	env      *config.Env
 	server   *grpc.Server
+	infra    *InfraApplyServiceV1
 	handlers []grpc.UnaryServerInterceptor
+	redactor *logging.Redactor
 }
 
 type BasiceClaim struct {
 	UID       string `json:"uid"`
+	Tenant    string `json:"tenant"`
 	ExpiresAt int64  `json:"exp"`
 }
 
@@ -99,13 +112,28 @@ func New(env *config.Env, opt ...grpc.ServerOption) *GrpcService {
 
 	s := new(GrpcService)
 	s.env = env
+	reqLog := env.Cfg.Log.Request
+	s.redactor = logging.NewRedactor(reqLog.RedactFields, reqLog.MaxFieldLen, reqLog.NoArgsMethods)
 
-	opt = append(opt, keepAlive, grpc.UnaryInterceptor(s.interceptor))
+	opt = append(opt, keepAlive, grpc.UnaryInterceptor(s.interceptor), grpc.ChainStreamInterceptor(s.streamRecovery(), s.streamHandle()))
 
 	s.server = grpc.NewServer(opt...)
-	s.Use(s.recovery(), s.handle(), s.logging())
+	s.Use(s.recovery(), s.handle(), s.logging(), s.idempotency())
 
-	v1.RegisterINFRAAPPLYServer(s.server, &InfraApplyServiceV1{env: env})
+	engine, err := policy.NewEngine()
+	if err != nil {
+		logger.Panic(err)
+	}
+	s.infra = &InfraApplyServiceV1{env: env, policy: engine}
+	if err := s.infra.ReloadPolicy(); err != nil {
+		logger.Panic(err)
+	}
+
+	v1.RegisterINFRAAPPLYServer(s.server, s.infra)
+	v1.RegisterDEVICEServer(s.server, &DeviceServiceV1{env: env})
+	v1.RegisterSUBJECTServer(s.server, &SubjectServiceV1{env: env})
+	v2.RegisterINFRAAPPLYServer(s.server, &InfraApplyServiceV2{v1: s.infra})
+	reflection.Register(s.server)
 
 	return s
 }
@@ -114,13 +142,17 @@ func New(env *config.Env, opt ...grpc.ServerOption) *GrpcService {
 func (s *GrpcService) Start(address string) error {
 	logger.Infof("starting grpc service at: %s", address)
 
-	listener, err := net.Listen("tcp", address)
+	listener, err := util.Listen(address)
 	if err != nil {
 		logger.Panic(err)
 		return err
 	}
 
-	reflection.Register(s.server)
+	return s.Serve(listener)
+}
+
+// Serve serves grpc on an accepted listener, like the in-memory one of the gateway.
+func (s *GrpcService) Serve(listener net.Listener) error {
 	return s.server.Serve(listener)
 }
 
@@ -180,7 +212,8 @@ func (s *GrpcService) recovery() grpc.UnaryServerInterceptor {
 				const size = 64 << 10
 				buf := make([]byte, size)
 				_ = runtime.Stack(buf, false)
-				logger.Errorf("grpc server panic: %v\n%v\n%s\n", req, rerr, buf)
+				reqArgs, _ := s.redactor.Args(args.FullMethod, req)
+				logger.Errorf("grpc server panic: %v\n%v\n%s\n", reqArgs, rerr, buf)
 				err = status.Errorf(codes.Unknown, fmt.Sprintf("%v", rerr))
 			}
 		}()
@@ -189,6 +222,62 @@ func (s *GrpcService) recovery() grpc.UnaryServerInterceptor {
 	}
 }
 
+// streamRecovery is a stream server interceptor that recovers from any panics.
+func (s *GrpcService) streamRecovery() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				const size = 64 << 10
+				buf := make([]byte, size)
+				_ = runtime.Stack(buf, false)
+				logger.Errorf("grpc server panic: %s\n%v\n%s\n", info.FullMethod, rerr, buf)
+				err = status.Errorf(codes.Unknown, fmt.Sprintf("%v", rerr))
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// identityStream is a server stream whose context carries the identity set by streamHandle
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
+
+// streamHandle identifies the caller of a stream like handle does for unary calls
+func (s *GrpcService) streamHandle() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "empty metadata")
+		}
+
+		var traceID string
+		if value, ok := md[_traceID]; ok && len(value) > 0 {
+			traceID = value[0]
+		} else {
+			traceID = uuid.New().String()
+			md.Append(_traceID, traceID)
+		}
+
+		uid, err := identify(ctx, s.env.Cfg, md)
+		if err != nil {
+			return err
+		}
+
+		ctx = metadata.NewIncomingContext(ctx, md)
+		ctx = logging.NewContext(ctx, logging.ForRequest(traceID, uid, info.FullMethod))
+		return handler(srv, &identityStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
 // tracing, auth 等几个拦截器分开写比较好
 // handle return a new unary server interceptor for Tracing\LinkTimeout\AuthToken
 func (s *GrpcService) handle() grpc.UnaryServerInterceptor {
@@ -205,32 +294,20 @@ func (s *GrpcService) handle() grpc.UnaryServerInterceptor {
 
 		var traceID string
 
-		_, ok = md[_traceID]
-		if !ok {
+		if value, ok := md[_traceID]; ok && len(value) > 0 {
+			traceID = value[0]
+		} else {
 			traceID = uuid.New().String()
 			md.Append(_traceID, traceID)
 		}
 
-		// 获取 header 的 authorization 字段的值建议用 go-grpc-middleware 里的 auth
-		//var token string
-		//if val, ok := md[_headerAuthz]; ok {
-		//	splits := strings.SplitN(val[0], " ", 2)
-		//	if len(splits) < 2 || splits[0] != _bearer {
-		//		return nil, status.Errorf(codes.Unauthenticated, "bad authorization string")
-		//	}
-		//
-		//	token = splits[1]
-		//}
-
-		//uid, _, err := parseToken(token, s.env.Cfg.Identify.AuthSecret)
-		//if err != nil {
-		//	return nil, status.Errorf(codes.Unauthenticated, "parse token failed:", err)
-		//}
-		//
-		//md.Append(_token, token)
-		//md.Append(_uid, uid)
+		uid, err := identify(ctx, s.env.Cfg, md)
+		if err != nil {
+			return nil, err
+		}
 
 		newCtx := metadata.NewIncomingContext(ctx, md)
+		newCtx = logging.NewContext(newCtx, logging.ForRequest(traceID, uid, args.FullMethod))
 
 		return handler(newCtx, req)
 	}
@@ -260,26 +337,18 @@ func (s *GrpcService) logging() grpc.UnaryServerInterceptor {
 			"path":          info.FullMethod,
 			"ts":            duration.Seconds(),
 			"timeout_quota": quota,
-			"args":          req.(fmt.Stringer).String(),
+		}
+		if args, ok := s.redactor.Args(info.FullMethod, req); ok {
+			logFields["args"] = args
 		}
 
 		if err != nil {
-			md, ok := metadata.FromIncomingContext(ctx)
-			if !ok {
-				return nil, status.Errorf(codes.InvalidArgument, "empty metadata")
-			}
-
-			var traceID string
-			if value, ok := md[_traceID]; ok {
-				traceID = value[0]
-			}
-
-			logFields[_traceID] = traceID
 			logFields["error"] = err.Error()
 			logFields["stack"] = fmt.Sprintf("%+v", err)
 		}
 
-		logger.WithFields(logFields).Debugf("grpc request:")
+		// the request logger has the trace_id, uid and method
+		logging.FromContext(ctx).WithFields(logFields).Debugf("grpc request:")
 		return resp, err
 	}
 }
@@ -288,25 +357,30 @@ func (s *GrpcService) logging() grpc.UnaryServerInterceptor {
 func (s *GrpcService) SignalHandler() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGHUP, syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGINT)
-	ch := <-c
-
-	logger.Infof("apiserver get %s signal", ch.String())
-	switch ch {
-	case syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGINT:
-		logger.Info("apiserver exit")
-		s.Stop()
-		s.env.SaveProfile()
-		s.env.MysqlCli.Close()
-		time.Sleep(time.Second)
-		return
-	case syscall.SIGHUP:
-		// TODO reload
-	default:
-		return
+	for {
+		ch := <-c
+
+		logger.Infof("apiserver get %s signal", ch.String())
+		switch ch {
+		case syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGINT:
+			logger.Info("apiserver exit")
+			s.Stop()
+			s.env.MysqlCli.Close()
+			s.env.Replicas.Close()
+			time.Sleep(time.Second)
+			return
+		case syscall.SIGHUP:
+			// keep the rules in use if the new ones are invalid
+			if err := s.infra.ReloadPolicy(); err != nil {
+				logger.Errorf("reload policy err: %v", err)
+			}
+		default:
+			return
+		}
 	}
 }
 
-func parseToken(tokenStr, authSecret string) (uid string, exp int64, err error) {
+func parseToken(tokenStr, authSecret string) (uid, tenant string, exp int64, err error) {
 	fn := func(token *jwt.Token) (interface{}, error) {
 		return []byte(authSecret), nil
 	}
@@ -324,32 +398,61 @@ func parseToken(tokenStr, authSecret string) (uid string, exp int64, err error)
 	}
 
 	uid = claim.UID
+	tenant = claim.Tenant
 	exp = claim.ExpiresAt
-	return uid, exp, nil
+	return uid, tenant, exp, nil
 }
 
-// GetUser is InfraApplyServiceV1's internal interface
+// GetUser is InfraApplyServiceV1's internal interface, it returns "" for an anonymous caller
 func (h *InfraApplyServiceV1) GetUser(ctx context.Context) string {
 	md, _ := metadata.FromIncomingContext(ctx) // ignore the `error` return value
-	return md[_uid][0]
+	if uid := md[_uid]; len(uid) > 0 {
+		return uid[0]
+	}
+	return ""
 }
 
-// List
-func (s *InfraApplyServiceV1) ListInfraApply(ctx context.Context, in *v1.ListInfraApplyReq) (*v1.ListInfraApplyReply, error) {
-	pageIdx, pageSize := in.PageIdx-1, in.PageSize
-	var limit, offset int32 = pageSize, pageSize * pageIdx
+// infraApplyQuery builds the exact match filters of a list request
+func infraApplyQuery(extendsID int32, st, applyer, deviceCode string) map[string]interface{} {
 	query := make(map[string]interface{})
+	if extendsID != 0 {
+		query["extends_id"] = extendsID
+	}
+	if st != "" {
+		query["status"] = st
+	}
+	if applyer != "" {
+		query["applyer"] = applyer
+	}
+	if deviceCode != "" {
+		query["device_code"] = deviceCode
+	}
+	return query
+}
 
-	search := make(map[string]interface{})
-	if in.Search != "" {
-		search["subject_name"] = in.Search
+// pageOf fills the defaults of a page, so GET /v1/applies works without paging parameters
+func pageOf(pageIdx, pageSize int32) (int32, int32) {
+	if pageIdx <= 0 {
+		pageIdx = 1
+	}
+	if pageSize == 0 {
+		pageSize = common.DEFAULT_PAGE_SIZE
 	}
+	return pageIdx, pageSize
+}
 
-	res, total, err := server.FindInfraApplyLikePattern(s.env.MysqlCli, query, search, limit, offset)
+// infraApplyDetails converts applies to their replies, with the devices from the inventory
+func (s *InfraApplyServiceV1) infraApplyDetails(ctx context.Context, res []model.InfraApply) ([]*v1.DetailInfraApplyReply, error) {
+	deviceCodes := make([]string, 0, len(res))
+	for _, ia := range res {
+		deviceCodes = append(deviceCodes, ia.DeviceCode)
+	}
+	devices, err := server.FindDeviceByCodes(readerOf(ctx, s.env), deviceCodes)
 	if err != nil {
 		return nil, err
 	}
-	ret := v1.ListInfraApplyReply{}
+
+	records := make([]*v1.DetailInfraApplyReply, 0, len(res))
 	for _, ia := range res {
 		rec := v1.DetailInfraApplyReply{
 			ID:          ia.ID,
@@ -358,10 +461,50 @@ func (s *InfraApplyServiceV1) ListInfraApply(ctx context.Context, in *v1.ListInf
 			Status:      ia.Status,
 			SubjectName: ia.SubjectName,
 			ReviewId:    ia.ReviewId,
-			ExpireTM:    ia.ExpiresAt.String(),
-			ReviewTM:    ia.ReviewedAt.String(),
+			ExpireTM:    util.TimeToStr(ia.ExpiresAt),
+			ReviewTM:    util.TimeToStr(ia.ReviewedAt),
+			Comment:     ia.Comment,
+			WorkflowID:  ia.WorkflowID,
+			Stage:       ia.Stage,
+			PolicyRule:  ia.PolicyRule,
+			ExtendsID:   ia.ExtendsID,
+		}
+		if d, ok := devices[ia.DeviceCode]; ok {
+			rec.Device = deviceDetail(d)
 		}
-		ret.Record = append(ret.Record, &rec)
+		records = append(records, &rec)
+	}
+	return records, nil
+}
+
+// List
+func (s *InfraApplyServiceV1) ListInfraApply(ctx context.Context, in *v1.ListInfraApplyReq) (*v1.ListInfraApplyReply, error) {
+	return cached(ctx, s.env, in, listTTL(s.env.Cfg), _applyTables, func() (*v1.ListInfraApplyReply, error) {
+		return s.listInfraApply(ctx, in)
+	})
+}
+
+// listInfraApply reads the reply of ListInfraApply on a miss of the cache
+func (s *InfraApplyServiceV1) listInfraApply(ctx context.Context, in *v1.ListInfraApplyReq) (*v1.ListInfraApplyReply, error) {
+	reqPageIdx, reqPageSize := pageOf(in.PageIdx, in.PageSize)
+	pageIdx, pageSize := reqPageIdx-1, reqPageSize
+	var limit, offset int32 = pageSize, pageSize * pageIdx
+	query := infraApplyQuery(in.ExtendsID, in.Status, in.Applyer, in.DeviceCode)
+
+	search := make(map[string]interface{})
+	if in.Search != "" {
+		search["subject_name"] = in.Search
+	}
+
+	res, total, err := server.FindInfraApplyLikePattern(readerOf(ctx, s.env), query, search, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := v1.ListInfraApplyReply{}
+	ret.Record, err = s.infraApplyDetails(ctx, res)
+	if err != nil {
+		return nil, err
 	}
 	ret.Page = &v1.ModelPage{PageSize: pageSize, PageIdx: pageIdx + 1, Total: int32(total)}
 	if (limit + offset) < int32(total) {
@@ -372,12 +515,116 @@ func (s *InfraApplyServiceV1) ListInfraApply(ctx context.Context, in *v1.ListInf
 	return &ret, nil
 }
 
+// GetInfraApply returns the apply with ID
+func (s *InfraApplyServiceV1) GetInfraApply(ctx context.Context, in *v1.GetInfraApplyReq) (*v1.DetailInfraApplyReply, error) {
+	return cached(ctx, s.env, in, detailTTL(s.env.Cfg), _applyTables, func() (*v1.DetailInfraApplyReply, error) {
+		return s.getInfraApply(ctx, in)
+	})
+}
+
+// getInfraApply reads the reply of GetInfraApply on a miss of the cache
+func (s *InfraApplyServiceV1) getInfraApply(ctx context.Context, in *v1.GetInfraApplyReq) (*v1.DetailInfraApplyReply, error) {
+	res, err := server.FindOneInfraApply(readerOf(ctx, s.env), map[string]interface{}{"id": in.ID})
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if res == nil {
+		return nil, status.Error(codes.NotFound, "empty result found")
+	}
+
+	records, err := s.infraApplyDetails(ctx, []model.InfraApply{*res})
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	return records[0], nil
+}
+
 func (s *InfraApplyServiceV1) AddInfraApply(ctx context.Context, in *v1.AddInfraApplyReq) (*v1.AddInfraApplyReply, error) {
-	return &v1.AddInfraApplyReply{}, nil
+	ret := v1.AddInfraApplyReply{}
+	if in.DeviceCode == "" || in.SubjectName == "" {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(deviceCode, subjectName)")
+	}
+	// the policy rules trust the applyer, it is the authenticated caller and never in.Uid
+	applyer := s.GetUser(ctx)
+	if applyer == "" {
+		return &ret, status.Error(codes.Unauthenticated, "adding an apply needs an authenticated caller")
+	}
+
+	ia := model.InfraApply{
+		DeviceCode:  in.DeviceCode,
+		Applyer:     applyer,
+		Status:      common.STATUS_INIT,
+		SubjectName: in.SubjectName,
+	}
+
+	if in.ExpireTM != "" {
+		expireTm, err := util.StrToTime(in.ExpireTM)
+		if err != nil {
+			return &ret, status.Error(codes.InvalidArgument, "invalid param(expireTm)")
+		}
+		ia.ExpiresAt = expireTm
+	}
+
+	if err := checkDevice(ctx, s.env, in.DeviceCode); err != nil {
+		return &ret, err
+	}
+
+	sub, err := server.FindOneSubject(dbOf(ctx, s.env), in.SubjectName)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+
+	now := time.Now()
+	ia.ExpiresAt, err = subjectExpiry(sub, s.env.Cfg.Tenant(tenantOf(ctx)), ia.ExpiresAt, now)
+	if err != nil {
+		return &ret, err
+	}
+
+	if err := s.routeInfraApply(ctx, &ia, sub, now); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+
+	err = s.withTx(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		ia.ID = 0 // set by a run rolled back by a deadlock
+		return addRoutedInfraApply(tx, &ia)
+	})
+	if err != nil {
+		return nil, txErr(ctx, err, "insert db err")
+	}
+
+	return &v1.AddInfraApplyReply{
+		Result:     common.RESP_SUCCESS,
+		ID:         ia.ID,
+		Status:     ia.Status,
+		PolicyRule: ia.PolicyRule,
+	}, nil
+}
+
+// addRoutedInfraApply inserts an apply that went through routeInfraApply, the decision of a
+// policy rule is kept in the review history like any other review
+func addRoutedInfraApply(tx *gorm.DB, ia *model.InfraApply) error {
+	if err := common.AddOne(tx, ia); err != nil {
+		return err
+	}
+	if ia.Status == common.STATUS_INIT {
+		return nil
+	}
+
+	review := model.InfraApplyReview{
+		ApplyID:  ia.ID,
+		Reviewer: ia.ReviewId,
+		Decision: ia.Status,
+		Comment:  "decided by policy rule " + ia.PolicyRule,
+	}
+	return common.AddOne(tx, &review)
 }
 
 func (s *InfraApplyServiceV1) UpdateInfraApply(ctx context.Context, in *v1.UpdateInfraApplyReq) (*v1.UpdateInfraApplyReply, error) {
-	//ok, err := server.CheckUserHasPermission(s.env.MysqlCli, s.GetUser(ctx), "tupam")
+	//ok, err := server.CheckUserHasPermission(dbOf(ctx, s.env), s.GetUser(ctx), "tupam")
 	//if err != nil {
 	//	logger.Errorf("server err: %v", err)
 	//	return nil, status.Error(codes.Internal, "query db err")
@@ -387,40 +634,282 @@ func (s *InfraApplyServiceV1) UpdateInfraApply(ctx context.Context, in *v1.Updat
 	//	return nil, status.Error(codes.PermissionDenied, "user has not permission")
 	//}
 
-	// query db: id
-	query := make(map[string]interface{})
-	query["id"] = in.ID
-	res, err := server.FindOneInfraApply(s.env.MysqlCli, query)
+	ret := v1.UpdateInfraApplyReply{}
+	st, expireTM, comment := in.Status, in.ExpireTM, ""
+	if in.Apply != nil {
+		var err error
+		if st, expireTM, comment, err = applyPatch(in.Apply, in.UpdateMask); err != nil {
+			return &ret, err
+		}
+	}
+
+	reviewer := s.GetUser(ctx)
+	updater, err := reviewUpdater(reviewer, st, expireTM, comment)
 	if err != nil {
-		logger.Errorf("server err: %v", err)
-		return nil, status.Error(codes.Internal, "query db err")
+		return &ret, err
+	}
+
+	decision := st
+	err = s.withTx(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		var err error
+		st, err = reviewOne(tx, s.env.Cfg.Tenant(tenantOf(ctx)), in.ID, reviewer, decision, comment, updater)
+		return err
+	})
+	if err != nil {
+		return &ret, txErr(ctx, err, "update db err")
+	}
+
+	return &v1.UpdateInfraApplyReply{Result: common.RESP_SUCCESS, Status: st}, nil
+}
+
+// BatchUpdateInfraApply reviews many applies in one transaction. By default one failure rolls back
+// the whole batch, with bestEffort the rest is still saved and every item reports its own result.
+// A batch holds at most PAGE_SIZE applies, whether listed by IDs or matched by the filters of
+// ListInfraApply.
+func (s *InfraApplyServiceV1) BatchUpdateInfraApply(ctx context.Context, in *v1.BatchUpdateInfraApplyReq) (*v1.BatchUpdateInfraApplyReply, error) {
+	ret := v1.BatchUpdateInfraApplyReply{}
+	switch in.Status {
+	case common.STATUS_APPROVED, common.STATUS_REFUSED, common.STATUS_EXPIRED:
+	default:
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(status)")
+	}
+
+	reviewer := s.GetUser(ctx)
+	updater, err := reviewUpdater(reviewer, in.Status, in.ExpireTM, in.Comment)
+	if err != nil {
+		return &ret, err
+	}
+
+	var ids []int32
+	seen := make(map[int32]bool, len(in.IDs))
+	for _, id := range in.IDs {
+		// an apply without workflow would be reviewed twice
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		if in.Search == "" && in.Applyer == "" && in.DeviceCode == "" && in.ExtendsID == 0 {
+			return &ret, status.Error(codes.InvalidArgument, "invalid param(IDs, search, applyer, deviceCode or extendsID)")
+		}
+
+		// only the pending applies can be reviewed
+		query := infraApplyQuery(in.ExtendsID, common.STATUS_INIT, in.Applyer, in.DeviceCode)
+		search := make(map[string]interface{})
+		if in.Search != "" {
+			search["subject_name"] = in.Search
+		}
+		res, total, err := server.FindInfraApplyLikePattern(dbOf(ctx, s.env), query, search, common.PAGE_SIZE, 0)
+		if err != nil {
+			logging.FromContext(ctx).Errorf("server err: %v", err)
+			return nil, status.Error(codes.Internal, "query db err")
+		}
+		// never review only the first page of the matches
+		if total > common.PAGE_SIZE {
+			return &ret, status.Errorf(codes.InvalidArgument, "search matches %d applies, at most %d", total, common.PAGE_SIZE)
+		}
+		for _, ia := range res {
+			ids = append(ids, ia.ID)
+		}
+	}
+
+	if len(ids) > common.PAGE_SIZE {
+		return &ret, status.Errorf(codes.InvalidArgument, "too many applies, at most %d", common.PAGE_SIZE)
+	}
+
+	var firstErr string
+	err = s.withTx(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		firstErr, ret.Items, ret.Succeeded, ret.Failed = "", nil, 0, 0
+		for _, id := range ids {
+			item := v1.BatchUpdateItem{ID: id, Result: common.RESP_SUCCESS}
+			if _, err := reviewOne(tx, s.env.Cfg.Tenant(tenantOf(ctx)), id, reviewer, in.Status, in.Comment, updater); err != nil {
+				if _, ok := status.FromError(err); !ok {
+					// the db failed, the transaction cannot go on
+					return err
+				}
+				item.Result = common.RESP_FAILED
+				item.Error = status.Convert(err).Message()
+				if firstErr == "" {
+					firstErr = fmt.Sprintf("apply %d: %s", id, item.Error)
+				}
+				ret.Failed++
+			} else {
+				ret.Succeeded++
+			}
+			ret.Items = append(ret.Items, &item)
+		}
+
+		if in.DryRun || (ret.Failed > 0 && !in.BestEffort) {
+			return errRollback
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, txErr(ctx, err, "update db err")
+	}
+	if !in.DryRun && ret.Failed > 0 && !in.BestEffort {
+		return nil, status.Errorf(codes.Aborted, "%d of %d applies failed, nothing updated, %s",
+			ret.Failed, len(ids), firstErr)
+	}
+
+	ret.Result = common.RESP_SUCCESS
+	if ret.Failed > 0 {
+		ret.Result = common.RESP_FAILED
+	}
+	return &ret, nil
+}
+
+// reviewOne records the decision of reviewer on the apply id with its audit entry, it returns the
+// status of the apply after the review. updater holds the columns written once the apply is
+// decided, tc is the config of the tenant tx is scoped to. The apply is locked until tx ends so
+// concurrent reviews see each other, the errors of the db are returned as they are. The reviewer
+// must be authenticated and cannot review their own apply or extension.
+func reviewOne(tx *gorm.DB, tc *config.TenantCfg, id int32, reviewer, decision, comment string,
+	updater map[string]interface{}) (string, error) {
+	if reviewer == "" {
+		return "", status.Error(codes.Unauthenticated, "reviewing an apply needs an authenticated reviewer")
+	}
+
+	res, err := server.LockInfraApply(tx, id)
+	if err != nil {
+		return "", err
 	}
 
-	ret := v1.UpdateInfraApplyReply{}
 	if res == nil {
-		return &ret, status.Error(codes.NotFound, "empty result found")
+		return "", status.Error(codes.NotFound, "empty result found")
+	}
+	if res.Applyer == reviewer {
+		return "", status.Error(codes.PermissionDenied, "user cannot review their own apply")
 	}
 
+	if expiresAt, ok := updater["expires_at"].(time.Time); ok {
+		sub, err := server.FindOneSubject(tx, res.SubjectName)
+		if err != nil {
+			return "", err
+		}
+		if _, err := subjectExpiry(sub, tc, expiresAt, time.Now()); err != nil {
+			return "", err
+		}
+	}
+
+	from := res.Status
+	review := model.InfraApplyReview{Reviewer: reviewer, Decision: decision, Comment: comment}
+	st, err := server.ReviewInfraApply(tx, res, &review, updater)
+	if err == nil && st == common.STATUS_APPROVED && res.ExtendsID != 0 {
+		expiresAt := res.ExpiresAt
+		if tm, ok := updater["expires_at"].(time.Time); ok {
+			expiresAt = tm
+		}
+		err = server.ExtendGrant(tx, res, expiresAt, reviewer)
+	}
+	if err == nil {
+		err = server.AuditReview(tx, res.ID, reviewer, decision, from, st)
+	}
+	switch err {
+	case nil:
+		return st, nil
+	case server.ErrReviewClosed:
+		return "", status.Error(codes.FailedPrecondition, err.Error())
+	case server.ErrInvalidDecision:
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	case server.ErrNotStageReviewer:
+		return "", status.Error(codes.PermissionDenied, err.Error())
+	case server.ErrAlreadyReviewed:
+		return "", status.Error(codes.AlreadyExists, err.Error())
+	case server.ErrNoReviewer:
+		return "", status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return "", err
+	}
+}
+
+// reviewUpdater builds the columns written when a reviewer decides on an apply
+func reviewUpdater(reviewer, st, expireTM, comment string) (map[string]interface{}, error) {
 	updater := make(map[string]interface{})
-	updater["status"] = in.Status
+	updater["status"] = st
 	updater["review_at"] = time.Now()
 
-	if in.ExpireTM != "" {
-		expireTm, err := util.StrToTime(in.ExpireTM)
+	if reviewer != "" {
+		updater["review_id"] = reviewer
+	}
+
+	if comment != "" {
+		updater["comment"] = comment
+	}
+
+	if expireTM != "" {
+		expireTm, err := util.StrToTime(expireTM)
 		if err != nil {
-			return &ret, status.Error(codes.InvalidArgument, "invalid param(expireTm)")
+			return nil, status.Error(codes.InvalidArgument, "invalid param(expireTm)")
 		}
-		updater["expire_tm"] = expireTm
+		updater["expires_at"] = expireTm
 	}
 
-	err = server.UpdateInfraApply(s.env.MysqlCli, res, updater)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "update db err")
+	return updater, nil
+}
+
+// applyPatch reads the fields of a PATCH request named by mask, an empty mask takes every
+// non empty field. The patch is a review, so status is needed.
+func applyPatch(patch *v1.InfraApplyPatch, mask *field_mask.FieldMask) (st, expireTM, comment string, err error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"status", "expireTM", "comment"}
 	}
 
-	return &v1.UpdateInfraApplyReply{Result: common.RESP_SUCCESS}, nil
+	for _, path := range paths {
+		switch path {
+		case "status":
+			st = patch.Status
+		case "expireTM":
+			expireTM = patch.ExpireTM
+		case "comment":
+			comment = patch.Comment
+		default:
+			return "", "", "", status.Errorf(codes.InvalidArgument, "invalid param(updateMask %s)", path)
+		}
+	}
+
+	if st == "" {
+		return "", "", "", status.Error(codes.InvalidArgument, "invalid param(status)")
+	}
+	return st, expireTM, comment, nil
 }
 
+// DelInfraApply withdraws an apply, only its applyer can do it and only while it waits for review
 func (s *InfraApplyServiceV1) DelInfraApply(ctx context.Context, in *v1.DelInfraApplyReq) (*v1.DelInfraApplyReply, error) {
-	return &v1.DelInfraApplyReply{}, nil
+	ret := v1.DelInfraApplyReply{}
+	id, err := strconv.Atoi(in.ID)
+	if err != nil {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(ID)")
+	}
+	// the applyer is the authenticated caller, the uid of the request is not trusted
+	caller := s.GetUser(ctx)
+	if caller == "" {
+		return &ret, status.Error(codes.Unauthenticated, "withdrawing an apply needs an authenticated caller")
+	}
+
+	// locked so that a review cannot decide the apply while it is withdrawn
+	err = s.withTx(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		res, err := server.LockInfraApply(tx, int32(id))
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			return status.Error(codes.NotFound, "empty result found")
+		}
+
+		if res.Applyer != caller {
+			return status.Error(codes.PermissionDenied, "only the applyer can withdraw an apply")
+		}
+		if res.Status != common.STATUS_INIT {
+			return status.Error(codes.FailedPrecondition, "only applies waiting for review can be withdrawn")
+		}
+		return server.DeleteInfraApply(tx, res)
+	})
+	if err != nil {
+		return &ret, txErr(ctx, err, "delete db err")
+	}
+
+	return &v1.DelInfraApplyReply{Result: common.RESP_SUCCESS}, nil
 }
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/apiserver/stats"
+	"big-infra/pkg/apiserver/util"
+	"big-infra/pkg/model"
+
+	"github.com/jinzhu/gorm"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatsRefresher rebuilds the summary of the applies behind GetInfraApplyStats
+type StatsRefresher struct {
+	env      *config.Env
+	interval time.Duration
+}
+
+// NewStatsRefresher news a StatsRefresher from the Stats config
+func NewStatsRefresher(env *config.Env) *StatsRefresher {
+	r := &StatsRefresher{env: env, interval: env.Cfg.Stats.Interval}
+	if r.interval <= 0 {
+		r.interval = common.STATS_INTERVAL
+	}
+	return r
+}
+
+// Run rebuilds the summary every interval until ctx is done
+func (r *StatsRefresher) Run(ctx context.Context) {
+	logger.Infof("apply stats refresher started, every %v", r.interval)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		if n, err := r.RefreshOnce(start); err != nil {
+			logger.Errorf("apply stats refresh err: %v", err)
+		} else {
+			logger.Debugf("apply stats refreshed, %d rows in %v", n, time.Since(start))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RefreshOnce rebuilds the summary of every tenant as of now and returns its number of rows
+func (r *StatsRefresher) RefreshOnce(now time.Time) (int, error) {
+	// the scan of every apply goes to a replica, the summary is behind anyway
+	reader := common.AllTenants(r.env.Replicas.Reader(""))
+	rows, err := server.InfraApplySummaryRows(reader)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	sum := stats.NewSummarizer(now, util.Location)
+	for rows.Next() {
+		var ia model.InfraApply
+		if err := server.ScanInfraApply(reader, rows, &ia); err != nil {
+			return 0, err
+		}
+		sum.Add(&ia)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	res := sum.Rows()
+	opts, err := r.env.Cfg.MySQL.TxOptions()
+	if err != nil {
+		return 0, err
+	}
+	db := common.AllTenants(r.env.MysqlCli)
+	err = common.WithTx(context.Background(), db, opts, func(_ context.Context, tx *gorm.DB) error {
+		return server.ReplaceApplyStats(tx, res)
+	})
+	return len(res), err
+}
+
+// GetInfraApplyStats aggregates the summary of the applies of the tenant of the caller
+func (s *InfraApplyServiceV1) GetInfraApplyStats(ctx context.Context, in *v1.GetInfraApplyStatsReq) (*v1.GetInfraApplyStatsReply, error) {
+	ret := v1.GetInfraApplyStatsReply{}
+	grouper, err := stats.NewGrouper(in.GroupBy, in.Bucket)
+	if err != nil {
+		return &ret, status.Errorf(codes.InvalidArgument, "invalid param(groupBy, bucket): %v", err)
+	}
+	for _, day := range []string{in.FromDay, in.ToDay} {
+		if day == "" {
+			continue
+		}
+		if _, err := stats.BucketOf(day, stats.Day); err != nil {
+			return &ret, status.Error(codes.InvalidArgument, "invalid param(fromDay, toDay)")
+		}
+	}
+
+	limit := int(in.Limit)
+	if limit == 0 {
+		limit = common.STATS_GROUPS
+	}
+	if limit < 0 || limit > common.STATS_MAX_GROUPS {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(limit)")
+	}
+
+	query := make(map[string]interface{})
+	for column, value := range map[string]string{
+		"status":       in.Status,
+		"subject_name": in.SubjectName,
+		"applyer":      in.Applyer,
+		"reviewer":     in.Reviewer,
+	} {
+		if value != "" {
+			query[column] = value
+		}
+	}
+
+	res, err := server.FindApplyStats(readerOf(ctx, s.env), query, in.FromDay, in.ToDay)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+
+	var refreshedAt time.Time
+	for i := range res {
+		if err := grouper.Add(&res[i]); err != nil {
+			logging.FromContext(ctx).Errorf("server err: %v", err)
+			return nil, status.Error(codes.Internal, "query db err")
+		}
+		if res[i].RefreshedAt.After(refreshedAt) {
+			refreshedAt = res[i].RefreshedAt
+		}
+	}
+
+	groups := grouper.Groups()
+	if len(groups) > limit {
+		groups, ret.Truncated = groups[:limit], true
+	}
+	for _, g := range groups {
+		ret.Group = append(ret.Group, &v1.InfraApplyStatsGroup{
+			Bucket:        g.Bucket,
+			Status:        g.Status,
+			SubjectName:   g.SubjectName,
+			Applyer:       g.Applyer,
+			Reviewer:      g.Reviewer,
+			Total:         g.Total,
+			Active:        g.Active,
+			Reviewed:      g.Reviewed,
+			LatencyP50Sec: g.Latency.Quantile(0.5).Seconds(),
+			LatencyP90Sec: g.Latency.Quantile(0.9).Seconds(),
+			LatencyP99Sec: g.Latency.Quantile(0.99).Seconds(),
+		})
+	}
+	ret.RefreshTM = util.TimeToStr(refreshedAt)
+	return &ret, nil
+}
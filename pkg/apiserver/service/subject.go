@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/config"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/model"
+
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubjectServiceV1 is the grpc service of the subject catalog
+type SubjectServiceV1 struct {
+	env *config.Env
+}
+
+func validRiskLevel(level string) bool {
+	switch level {
+	case common.RISK_LOW, common.RISK_MEDIUM, common.RISK_HIGH, common.RISK_CRITICAL:
+		return true
+	}
+	return false
+}
+
+func subjectDetail(sub *model.Subject) *v1.SubjectDetail {
+	return &v1.SubjectDetail{
+		ID:                 sub.ID,
+		Name:               sub.Name,
+		Description:        sub.Description,
+		Owners:             sub.OwnerList(),
+		MaxDurationSec:     sub.MaxDuration,
+		DefaultDurationSec: sub.DefaultDuration,
+		RiskLevel:          sub.RiskLevel,
+		Workflow:           sub.Workflow,
+	}
+}
+
+// checkSubject validates the limits and the required workflow of sub
+func checkSubject(ctx context.Context, env *config.Env, sub *model.Subject) error {
+	if !validRiskLevel(sub.RiskLevel) {
+		return status.Error(codes.InvalidArgument, "invalid param(riskLevel)")
+	}
+	if sub.MaxDuration < 0 || sub.DefaultDuration < 0 {
+		return status.Error(codes.InvalidArgument, "invalid param(maxDurationSec, defaultDurationSec)")
+	}
+	if sub.MaxDuration > 0 && sub.DefaultDuration > sub.MaxDuration {
+		return status.Error(codes.InvalidArgument, "defaultDurationSec is longer than maxDurationSec")
+	}
+
+	if sub.Workflow != "" {
+		wf, err := server.FindWorkflowByName(dbOf(ctx, env), sub.Workflow)
+		if err != nil {
+			logger.Errorf("server err: %v", err)
+			return status.Error(codes.Internal, "query db err")
+		}
+		if wf == nil {
+			return status.Errorf(codes.InvalidArgument, "unknown workflow %s", sub.Workflow)
+		}
+	}
+
+	return nil
+}
+
+// AddSubject adds a subject to the catalog
+func (s *SubjectServiceV1) AddSubject(ctx context.Context, in *v1.AddSubjectReq) (*v1.AddSubjectReply, error) {
+	ret := v1.AddSubjectReply{}
+	if err := checkTenantAdmin(ctx, s.env.Cfg); err != nil {
+		return &ret, err
+	}
+	if in.Name == "" {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(name)")
+	}
+
+	sub := model.Subject{
+		Name:            in.Name,
+		Description:     in.Description,
+		Owners:          strings.Join(in.Owners, ","),
+		MaxDuration:     in.MaxDurationSec,
+		DefaultDuration: in.DefaultDurationSec,
+		RiskLevel:       in.RiskLevel,
+		Workflow:        in.Workflow,
+	}
+	if sub.RiskLevel == "" {
+		sub.RiskLevel = common.RISK_LOW
+	}
+	if err := checkSubject(ctx, s.env, &sub); err != nil {
+		return &ret, err
+	}
+
+	exist, err := server.FindOneSubject(dbOf(ctx, s.env), in.Name)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if exist != nil {
+		return &ret, status.Error(codes.AlreadyExists, "subject already exists")
+	}
+
+	if err := common.AddOne(dbOf(ctx, s.env), &sub); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "insert db err")
+	}
+
+	return &v1.AddSubjectReply{Result: common.RESP_SUCCESS, ID: sub.ID}, nil
+}
+
+// UpdateSubject changes the non empty fields of a subject
+func (s *SubjectServiceV1) UpdateSubject(ctx context.Context, in *v1.UpdateSubjectReq) (*v1.UpdateSubjectReply, error) {
+	ret := v1.UpdateSubjectReply{}
+	if err := checkTenantAdmin(ctx, s.env.Cfg); err != nil {
+		return &ret, err
+	}
+	sub, err := server.FindOneSubject(dbOf(ctx, s.env), in.Name)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if sub == nil {
+		return &ret, status.Error(codes.NotFound, "empty result found")
+	}
+
+	updater := make(map[string]interface{})
+	if in.Description != "" {
+		sub.Description = in.Description
+		updater["description"] = in.Description
+	}
+	if len(in.Owners) > 0 {
+		sub.Owners = strings.Join(in.Owners, ",")
+		updater["owners"] = sub.Owners
+	}
+	if in.MaxDurationSec != 0 {
+		sub.MaxDuration = in.MaxDurationSec
+		if sub.MaxDuration < 0 {
+			sub.MaxDuration = 0
+		}
+		updater["max_duration"] = sub.MaxDuration
+	}
+	if in.DefaultDurationSec != 0 {
+		sub.DefaultDuration = in.DefaultDurationSec
+		if sub.DefaultDuration < 0 {
+			sub.DefaultDuration = 0
+		}
+		updater["default_duration"] = sub.DefaultDuration
+	}
+	if in.RiskLevel != "" {
+		sub.RiskLevel = in.RiskLevel
+		updater["risk_level"] = in.RiskLevel
+	}
+	if in.ClearWorkflow {
+		sub.Workflow = ""
+		updater["workflow"] = ""
+	} else if in.Workflow != "" {
+		sub.Workflow = in.Workflow
+		updater["workflow"] = in.Workflow
+	}
+	if len(updater) == 0 {
+		return &v1.UpdateSubjectReply{Result: common.RESP_SUCCESS}, nil
+	}
+
+	// the limits are checked as they will be after the update
+	if err := checkSubject(ctx, s.env, sub); err != nil {
+		return &ret, err
+	}
+
+	if err := server.UpdateSubject(dbOf(ctx, s.env), sub, updater); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "update db err")
+	}
+
+	return &v1.UpdateSubjectReply{Result: common.RESP_SUCCESS}, nil
+}
+
+// GetSubject returns the subject with name
+func (s *SubjectServiceV1) GetSubject(ctx context.Context, in *v1.GetSubjectReq) (*v1.SubjectDetail, error) {
+	return cached(ctx, s.env, in, detailTTL(s.env.Cfg), _subjectTables, func() (*v1.SubjectDetail, error) {
+		return s.getSubject(ctx, in)
+	})
+}
+
+// getSubject reads the reply of GetSubject on a miss of the cache
+func (s *SubjectServiceV1) getSubject(ctx context.Context, in *v1.GetSubjectReq) (*v1.SubjectDetail, error) {
+	sub, err := server.FindOneSubject(readerOf(ctx, s.env), in.Name)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if sub == nil {
+		return nil, status.Error(codes.NotFound, "empty result found")
+	}
+
+	return subjectDetail(sub), nil
+}
+
+// ListSubject lists subjects, search matches the name or description
+func (s *SubjectServiceV1) ListSubject(ctx context.Context, in *v1.ListSubjectReq) (*v1.ListSubjectReply, error) {
+	reqPageIdx, reqPageSize := pageOf(in.PageIdx, in.PageSize)
+	if reqPageSize < 0 || reqPageSize > common.PAGE_SIZE {
+		return nil, status.Error(codes.InvalidArgument, "invalid param(pageSize)")
+	}
+	pageIdx, pageSize := reqPageIdx-1, reqPageSize
+	var limit, offset int32 = pageSize, pageSize * pageIdx
+	query := make(map[string]interface{})
+	if in.RiskLevel != "" {
+		query["risk_level"] = in.RiskLevel
+	}
+
+	res, total, err := server.FindSubjectLikePattern(readerOf(ctx, s.env), query, in.Search, in.Owner, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := v1.ListSubjectReply{}
+	for i := range res {
+		ret.Record = append(ret.Record, subjectDetail(&res[i]))
+	}
+	ret.Page = &v1.ModelPage{PageSize: pageSize, PageIdx: pageIdx + 1, Total: int32(total)}
+	ret.Exhausted = (limit + offset) >= int32(total)
+	return &ret, nil
+}
+
+// DelSubject removes a subject from the catalog, its applies are kept
+func (s *SubjectServiceV1) DelSubject(ctx context.Context, in *v1.DelSubjectReq) (*v1.DelSubjectReply, error) {
+	ret := v1.DelSubjectReply{}
+	if err := checkTenantAdmin(ctx, s.env.Cfg); err != nil {
+		return &ret, err
+	}
+	sub, err := server.FindOneSubject(dbOf(ctx, s.env), in.Name)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if sub == nil {
+		return &ret, status.Error(codes.NotFound, "empty result found")
+	}
+
+	if err := server.DeleteSubject(dbOf(ctx, s.env), sub); err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "delete db err")
+	}
+
+	return &v1.DelSubjectReply{Result: common.RESP_SUCCESS}, nil
+}
+
+// subjectExpiry returns the expiry of a grant of sub starting at now. A zero expiresAt takes the
+// default duration of the subject, or its maximum when it has no default, or else the default
+// duration of the tenant, and the grant may outlast neither the maximum of the subject nor the
+// one of the tenant. sub may be nil for subjects missing from the catalog, tc for tenants
+// without config.
+func subjectExpiry(sub *model.Subject, tc *config.TenantCfg, expiresAt, now time.Time) (time.Time, error) {
+	var subMax, tenantMax time.Duration
+	if sub != nil {
+		subMax = time.Duration(sub.MaxDuration) * time.Second
+	}
+	if tc != nil {
+		tenantMax = tc.MaxDuration
+	}
+
+	if expiresAt.IsZero() {
+		switch {
+		case sub != nil && sub.DefaultDuration > 0:
+			expiresAt = now.Add(time.Duration(sub.DefaultDuration) * time.Second)
+		case subMax > 0:
+			expiresAt = now.Add(subMax)
+		case tc != nil && tc.DefaultDuration > 0:
+			expiresAt = now.Add(tc.DefaultDuration)
+		case tenantMax > 0:
+			expiresAt = now.Add(tenantMax)
+		}
+		if tenantMax > 0 && expiresAt.Sub(now) > tenantMax {
+			expiresAt = now.Add(tenantMax)
+		}
+		return expiresAt, nil
+	}
+
+	if subMax > 0 && expiresAt.Sub(now) > subMax {
+		return expiresAt, status.Errorf(codes.InvalidArgument, "subject %s may be granted for at most %v",
+			sub.Name, subMax)
+	}
+	if tenantMax > 0 && expiresAt.Sub(now) > tenantMax {
+		return expiresAt, status.Errorf(codes.InvalidArgument, "tenant %s may be granted for at most %v",
+			tc.ID, tenantMax)
+	}
+	return expiresAt, nil
+}
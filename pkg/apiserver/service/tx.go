@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/logging"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errRollback makes withTx roll back and return nil, for dry runs and all or nothing batches
+var errRollback = errors.New("rollback")
+
+// withTx runs fn in a transaction of the tenant of ctx with the options of the MySQL config, see
+// common.WithTx. fn is run again after a deadlock, so it must reset what it collects. Within fn
+// dbOf(ctx, ...) returns the transaction.
+func (s *InfraApplyServiceV1) withTx(ctx context.Context, fn func(ctx context.Context, tx *gorm.DB) error) error {
+	opts, err := s.env.Cfg.MySQL.TxOptions()
+	if err != nil {
+		return err
+	}
+
+	err = common.WithTx(ctx, dbOf(ctx, s.env), opts, fn)
+	if err == errRollback {
+		return nil
+	}
+	return err
+}
+
+// txErr returns the status errors of a transaction as they are, the others are logged and
+// returned as Internal with msg
+func txErr(ctx context.Context, err error, msg string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	logging.FromContext(ctx).Errorf("server err: %v", err)
+	return status.Error(codes.Internal, msg)
+}
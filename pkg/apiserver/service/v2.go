@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	v2 "big-infra/pkg/apiserver/api/v2"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/apiserver/util"
+	"big-infra/pkg/model"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InfraApplyServiceV2 is the v2 api of applies, it differs from v1 in carrying times as
+// Timestamp, the mutating methods run the v1 ones
+type InfraApplyServiceV2 struct {
+	v1 *InfraApplyServiceV1
+}
+
+// timestampProto converts t, a zero t is left unset
+func timestampProto(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
+
+// timeStr converts ts to a v1 string time, an unset ts is ""
+func timeStr(ts *timestamp.Timestamp, name string) (string, error) {
+	if ts == nil {
+		return "", nil
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid param(%s)", name)
+	}
+	return util.TimeToStr(t), nil
+}
+
+func infraApplyDetailV2(ia *model.InfraApply) *v2.InfraApplyDetail {
+	return &v2.InfraApplyDetail{
+		ID:          ia.ID,
+		DeviceCode:  ia.DeviceCode,
+		Applyer:     ia.Applyer,
+		Status:      ia.Status,
+		SubjectName: ia.SubjectName,
+		ReviewId:    ia.ReviewId,
+		ExpireTime:  timestampProto(ia.ExpiresAt),
+		ReviewTime:  timestampProto(ia.ReviewedAt),
+		Comment:     ia.Comment,
+		WorkflowID:  ia.WorkflowID,
+		Stage:       ia.Stage,
+		PolicyRule:  ia.PolicyRule,
+		ExtendsID:   ia.ExtendsID,
+	}
+}
+
+// ListInfraApply lists applies, search matches the subject name
+func (s *InfraApplyServiceV2) ListInfraApply(ctx context.Context, in *v2.ListInfraApplyReq) (*v2.ListInfraApplyReply, error) {
+	return cached(ctx, s.v1.env, in, listTTL(s.v1.env.Cfg), _applyTables, func() (*v2.ListInfraApplyReply, error) {
+		return s.listInfraApply(ctx, in)
+	})
+}
+
+// listInfraApply reads the reply of ListInfraApply on a miss of the cache
+func (s *InfraApplyServiceV2) listInfraApply(ctx context.Context, in *v2.ListInfraApplyReq) (*v2.ListInfraApplyReply, error) {
+	reqPageIdx, reqPageSize := pageOf(in.PageIdx, in.PageSize)
+	pageIdx, pageSize := reqPageIdx-1, reqPageSize
+	var limit, offset int32 = pageSize, pageSize * pageIdx
+	query := infraApplyQuery(in.ExtendsID, in.Status, in.Applyer, in.DeviceCode)
+
+	search := make(map[string]interface{})
+	if in.Search != "" {
+		search["subject_name"] = in.Search
+	}
+
+	res, total, err := server.FindInfraApplyLikePattern(readerOf(ctx, s.v1.env), query, search, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := v2.ListInfraApplyReply{}
+	for i := range res {
+		ret.Record = append(ret.Record, infraApplyDetailV2(&res[i]))
+	}
+	ret.Page = &v2.ModelPage{PageSize: pageSize, PageIdx: pageIdx + 1, Total: int32(total)}
+	ret.Exhausted = (limit + offset) >= int32(total)
+	return &ret, nil
+}
+
+// GetInfraApply returns the apply with ID
+func (s *InfraApplyServiceV2) GetInfraApply(ctx context.Context, in *v2.GetInfraApplyReq) (*v2.InfraApplyDetail, error) {
+	return cached(ctx, s.v1.env, in, detailTTL(s.v1.env.Cfg), _applyTables, func() (*v2.InfraApplyDetail, error) {
+		return s.getInfraApply(ctx, in)
+	})
+}
+
+// getInfraApply reads the reply of GetInfraApply on a miss of the cache
+func (s *InfraApplyServiceV2) getInfraApply(ctx context.Context, in *v2.GetInfraApplyReq) (*v2.InfraApplyDetail, error) {
+	res, err := server.FindOneInfraApply(readerOf(ctx, s.v1.env), map[string]interface{}{"id": in.ID})
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+	if res == nil {
+		return nil, status.Error(codes.NotFound, "empty result found")
+	}
+
+	return infraApplyDetailV2(res), nil
+}
+
+// AddInfraApply adds an apply, see the v1 AddInfraApply
+func (s *InfraApplyServiceV2) AddInfraApply(ctx context.Context, in *v2.AddInfraApplyReq) (*v2.AddInfraApplyReply, error) {
+	expireTM, err := timeStr(in.ExpireTime, "expireTime")
+	if err != nil {
+		return &v2.AddInfraApplyReply{}, err
+	}
+
+	// the applyer is the authenticated caller of ctx, in.Uid is not trusted
+	resp, err := s.v1.AddInfraApply(ctx, &v1.AddInfraApplyReq{
+		DeviceCode:  in.DeviceCode,
+		SubjectName: in.SubjectName,
+		ExpireTM:    expireTM,
+	})
+	if resp == nil {
+		return nil, err
+	}
+	return &v2.AddInfraApplyReply{
+		Result:     resp.Result,
+		ID:         resp.ID,
+		Status:     resp.Status,
+		PolicyRule: resp.PolicyRule,
+	}, err
+}
+
+// UpdateInfraApply reviews an apply, see the v1 UpdateInfraApply
+func (s *InfraApplyServiceV2) UpdateInfraApply(ctx context.Context, in *v2.UpdateInfraApplyReq) (*v2.UpdateInfraApplyReply, error) {
+	expireTM, err := timeStr(in.ExpireTime, "expireTime")
+	if err != nil {
+		return &v2.UpdateInfraApplyReply{}, err
+	}
+
+	resp, err := s.v1.UpdateInfraApply(ctx, &v1.UpdateInfraApplyReq{
+		ID:       in.ID,
+		Status:   in.Status,
+		ExpireTM: expireTM,
+	})
+	if resp == nil {
+		return nil, err
+	}
+	return &v2.UpdateInfraApplyReply{Result: resp.Result, Status: resp.Status}, err
+}
+
+// ExtendInfraApply asks for a new expiry of a grant, see the v1 ExtendInfraApply
+func (s *InfraApplyServiceV2) ExtendInfraApply(ctx context.Context, in *v2.ExtendInfraApplyReq) (*v2.ExtendInfraApplyReply, error) {
+	expireTM, err := timeStr(in.ExpireTime, "expireTime")
+	if err != nil {
+		return &v2.ExtendInfraApplyReply{}, err
+	}
+
+	// the applyer is the authenticated caller of ctx, in.Uid is not trusted
+	resp, err := s.v1.ExtendInfraApply(ctx, &v1.ExtendInfraApplyReq{
+		ID:       in.ID,
+		ExpireTM: expireTM,
+		Comment:  in.Comment,
+	})
+	if resp == nil {
+		return nil, err
+	}
+	return &v2.ExtendInfraApplyReply{
+		Result:     resp.Result,
+		ID:         resp.ID,
+		Status:     resp.Status,
+		PolicyRule: resp.PolicyRule,
+	}, err
+}
+
+// ListInfraApplyReview lists the reviews of an apply in the order they were made
+func (s *InfraApplyServiceV2) ListInfraApplyReview(ctx context.Context, in *v2.ListInfraApplyReviewReq) (*v2.ListInfraApplyReviewReply, error) {
+	reviews, err := server.FindInfraApplyReviews(readerOf(ctx, s.v1.env), in.ID)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+
+	ret := v2.ListInfraApplyReviewReply{}
+	for _, r := range reviews {
+		ret.Record = append(ret.Record, &v2.InfraApplyReviewDetail{
+			Stage:      r.Stage,
+			Reviewer:   r.Reviewer,
+			Decision:   r.Decision,
+			Comment:    r.Comment,
+			ReviewTime: timestampProto(r.CreatedAt),
+		})
+	}
+	return &ret, nil
+}
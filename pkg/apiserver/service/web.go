@@ -0,0 +1,34 @@
+package service
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+)
+
+// Handler serves grpc and grpc-web requests on an http listener and passes the others to next,
+// it is how SinglePort mode shares one port between grpc and the http gateway
+func (s *GrpcService) Handler(next http.Handler) http.Handler {
+	origins := s.env.Cfg.GrpcSrv.WebOrigins
+	web := grpcweb.WrapServer(s.server, grpcweb.WithOriginFunc(func(origin string) bool {
+		for _, o := range origins {
+			if o == "*" || o == origin {
+				return true
+			}
+		}
+		return false
+	}))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		// grpc-web content types start with application/grpc too
+		case web.IsGrpcWebRequest(r) || web.IsAcceptableGrpcCorsRequest(r):
+			web.ServeHTTP(w, r)
+		case r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc"):
+			s.server.ServeHTTP(w, r)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
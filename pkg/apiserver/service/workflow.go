@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/apiserver/logging"
+	"big-infra/pkg/apiserver/server"
+	"big-infra/pkg/apiserver/util"
+	"big-infra/pkg/model"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListInfraApplyReview lists the reviews of an apply in the order they were made
+func (s *InfraApplyServiceV1) ListInfraApplyReview(ctx context.Context, in *v1.ListInfraApplyReviewReq) (*v1.ListInfraApplyReviewReply, error) {
+	reviews, err := server.FindInfraApplyReviews(readerOf(ctx, s.env), in.ID)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("server err: %v", err)
+		return nil, status.Error(codes.Internal, "query db err")
+	}
+
+	ret := v1.ListInfraApplyReviewReply{}
+	for _, r := range reviews {
+		rec := v1.InfraApplyReviewDetail{
+			Stage:    r.Stage,
+			Reviewer: r.Reviewer,
+			Decision: r.Decision,
+			Comment:  r.Comment,
+			ReviewTM: util.TimeToStr(r.CreatedAt),
+		}
+		ret.Record = append(ret.Record, &rec)
+	}
+	return &ret, nil
+}
+
+// SaveWorkflow saves a new version of a workflow and attaches it to the subjects in the request.
+// Applies already in flight keep the version they started with.
+func (s *InfraApplyServiceV1) SaveWorkflow(ctx context.Context, in *v1.SaveWorkflowReq) (*v1.SaveWorkflowReply, error) {
+	ret := v1.SaveWorkflowReply{}
+	if err := checkTenantAdmin(ctx, s.env.Cfg); err != nil {
+		return &ret, err
+	}
+	if in.Name == "" || len(in.Stages) == 0 {
+		return &ret, status.Error(codes.InvalidArgument, "invalid param(name, stages)")
+	}
+
+	stages := make([]model.WorkflowStage, 0, len(in.Stages))
+	for _, st := range in.Stages {
+		if st.Seq < 1 || len(st.Reviewers) == 0 {
+			return &ret, status.Error(codes.InvalidArgument, "invalid param(stage seq, reviewers)")
+		}
+		if st.Quorum < 0 || int(st.Quorum) > len(st.Reviewers) {
+			return &ret, status.Errorf(codes.InvalidArgument, "invalid param(stage %d quorum)", st.Seq)
+		}
+		stages = append(stages, model.WorkflowStage{
+			Seq:       st.Seq,
+			Name:      st.Name,
+			Reviewers: strings.Join(st.Reviewers, ","),
+			Quorum:    st.Quorum,
+		})
+	}
+
+	wf := model.Workflow{Name: in.Name, Description: in.Description}
+	err := s.withTx(ctx, func(ctx context.Context, tx *gorm.DB) error {
+		// the ids are set by a run rolled back by a deadlock
+		wf.ID = 0
+		for i := range stages {
+			stages[i].ID = 0
+		}
+		return server.SaveWorkflow(tx, &wf, stages, in.Subjects)
+	})
+	if err != nil {
+		return nil, txErr(ctx, err, "insert db err")
+	}
+
+	return &v1.SaveWorkflowReply{Result: common.RESP_SUCCESS, ID: wf.ID}, nil
+}
+
+// ListWorkflow lists workflows with their stages and attached subjects
+func (s *InfraApplyServiceV1) ListWorkflow(ctx context.Context, in *v1.ListWorkflowReq) (*v1.ListWorkflowReply, error) {
+	reqPageIdx, reqPageSize := pageOf(in.PageIdx, in.PageSize)
+	if reqPageSize < 0 || reqPageSize > common.PAGE_SIZE {
+		return nil, status.Error(codes.InvalidArgument, "invalid param(pageSize)")
+	}
+	pageIdx, pageSize := reqPageIdx-1, reqPageSize
+	var limit, offset int32 = pageSize, pageSize * pageIdx
+
+	res, total, err := server.FindWorkflow(readerOf(ctx, s.env), map[string]interface{}{}, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := v1.ListWorkflowReply{}
+	for _, wf := range res {
+		stages, err := server.FindWorkflowStages(readerOf(ctx, s.env), wf.ID)
+		if err != nil {
+			logging.FromContext(ctx).Errorf("server err: %v", err)
+			return nil, status.Error(codes.Internal, "query db err")
+		}
+		subjects, err := server.FindWorkflowSubjects(readerOf(ctx, s.env), wf.ID)
+		if err != nil {
+			logging.FromContext(ctx).Errorf("server err: %v", err)
+			return nil, status.Error(codes.Internal, "query db err")
+		}
+
+		rec := v1.WorkflowDetail{
+			ID:          wf.ID,
+			Name:        wf.Name,
+			Description: wf.Description,
+			Subjects:    subjects,
+		}
+		for i := range stages {
+			rec.Stages = append(rec.Stages, &v1.WorkflowStage{
+				Seq:       stages[i].Seq,
+				Name:      stages[i].Name,
+				Reviewers: stages[i].ReviewerList(),
+				Quorum:    stages[i].Quorum,
+			})
+		}
+		ret.Record = append(ret.Record, &rec)
+	}
+	ret.Page = &v1.ModelPage{PageSize: pageSize, PageIdx: pageIdx + 1, Total: int32(total)}
+	return &ret, nil
+}
@@ -0,0 +1,147 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"big-infra/pkg/model"
+)
+
+// the dimensions a summary can be grouped by
+const (
+	ByStatus   = "status"
+	BySubject  = "subject"
+	ByApplyer  = "applyer"
+	ByReviewer = "reviewer"
+)
+
+// the time buckets, a bucket is named by its first day
+const (
+	Day   = "day"
+	Week  = "week" // starting on Monday
+	Month = "month"
+)
+
+const dayLayout = "2006-01-02"
+
+// Key is a group of the summary, the fields it is not grouped by are empty
+type Key struct {
+	Bucket      string
+	Status      string
+	SubjectName string
+	Applyer     string
+	Reviewer    string
+}
+
+// Group is the aggregate of the summary rows of a Key
+type Group struct {
+	Key
+	Total    int64
+	Active   int64
+	Reviewed int64
+	Latency  Histogram
+}
+
+// Grouper adds summary rows up by some dimensions and a time bucket
+type Grouper struct {
+	by     map[string]bool
+	bucket string
+	groups map[Key]*Group
+}
+
+// NewGrouper groups by the dimensions of by and the bucket, an empty bucket does not split by time
+func NewGrouper(by []string, bucket string) (*Grouper, error) {
+	g := &Grouper{by: make(map[string]bool), bucket: bucket, groups: make(map[Key]*Group)}
+	for _, d := range by {
+		switch d {
+		case ByStatus, BySubject, ByApplyer, ByReviewer:
+			g.by[d] = true
+		default:
+			return nil, fmt.Errorf("unknown dimension %q", d)
+		}
+	}
+	switch bucket {
+	case "", Day, Week, Month:
+	default:
+		return nil, fmt.Errorf("unknown bucket %q", bucket)
+	}
+	return g, nil
+}
+
+// Add adds row to its group
+func (g *Grouper) Add(row *model.ApplyStat) error {
+	hist, err := ParseHistogram(row.LatencyHist)
+	if err != nil {
+		return fmt.Errorf("stat %d: %v", row.ID, err)
+	}
+
+	var k Key
+	if g.bucket != "" {
+		if k.Bucket, err = BucketOf(row.Day, g.bucket); err != nil {
+			return fmt.Errorf("stat %d: %v", row.ID, err)
+		}
+	}
+	if g.by[ByStatus] {
+		k.Status = row.Status
+	}
+	if g.by[BySubject] {
+		k.SubjectName = row.SubjectName
+	}
+	if g.by[ByApplyer] {
+		k.Applyer = row.Applyer
+	}
+	if g.by[ByReviewer] {
+		k.Reviewer = row.Reviewer
+	}
+
+	grp, ok := g.groups[k]
+	if !ok {
+		grp = &Group{Key: k, Latency: NewHistogram()}
+		g.groups[k] = grp
+	}
+	grp.Total += row.Total
+	grp.Active += row.Active
+	grp.Reviewed += row.Reviewed
+	grp.Latency.Merge(hist)
+	return nil
+}
+
+// Groups returns the groups by bucket, and the largest first in a bucket
+func (g *Grouper) Groups() []*Group {
+	res := make([]*Group, 0, len(g.groups))
+	for _, grp := range g.groups {
+		res = append(res, grp)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i], res[j]
+		if a.Bucket != b.Bucket {
+			return a.Bucket < b.Bucket
+		}
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		return fmt.Sprint(a.Key) < fmt.Sprint(b.Key)
+	})
+	return res
+}
+
+// BucketOf returns the first day of the bucket of day, days are 2006-01-02
+func BucketOf(day, bucket string) (string, error) {
+	t, err := time.Parse(dayLayout, day)
+	if err != nil {
+		return "", err
+	}
+	switch bucket {
+	case Week:
+		t = t.AddDate(0, 0, -(int(t.Weekday())+6)%7)
+	case Month:
+		t = t.AddDate(0, 0, 1-t.Day())
+	}
+	return t.Format(dayLayout), nil
+}
+
+// DayOf returns the summary day of t in loc
+func DayOf(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(dayLayout)
+}
@@ -0,0 +1,106 @@
+// Package stats aggregates the rows of the apply summary. Review latencies are kept as
+// histograms, so the percentiles of any group of rows are found by adding their histograms.
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Bounds are the upper bounds of the latency histogram buckets, a last bucket holds the
+// latencies above the largest bound
+var Bounds = []time.Duration{
+	time.Minute, 5 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 4 * time.Hour, 8 * time.Hour, 12 * time.Hour,
+	24 * time.Hour, 2 * 24 * time.Hour, 3 * 24 * time.Hour, 7 * 24 * time.Hour, 14 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+}
+
+// Histogram counts latencies in the buckets of Bounds
+type Histogram []int64
+
+// NewHistogram returns an empty Histogram
+func NewHistogram() Histogram {
+	return make(Histogram, len(Bounds)+1)
+}
+
+// ParseHistogram reads a histogram written by String, empty is an empty histogram
+func ParseHistogram(s string) (Histogram, error) {
+	h := NewHistogram()
+	if s == "" {
+		return h, nil
+	}
+	parts := strings.Split(s, ",")
+	if len(parts) != len(h) {
+		return nil, fmt.Errorf("histogram has %d buckets, want %d", len(parts), len(h))
+	}
+	for i, p := range parts {
+		n, err := strconv.ParseInt(p, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		h[i] = n
+	}
+	return h, nil
+}
+
+// Add counts d
+func (h Histogram) Add(d time.Duration) {
+	i := sort.Search(len(Bounds), func(i int) bool { return d <= Bounds[i] })
+	h[i]++
+}
+
+// Merge adds the counts of o
+func (h Histogram) Merge(o Histogram) {
+	for i := range h {
+		h[i] += o[i]
+	}
+}
+
+// Count is how many latencies h counts
+func (h Histogram) Count() int64 {
+	var n int64
+	for _, c := range h {
+		n += c
+	}
+	return n
+}
+
+// Quantile estimates the q quantile, q in [0, 1], by interpolating in its bucket. The latencies of
+// the last bucket are taken as the largest bound. It is 0 for an empty histogram.
+func (h Histogram) Quantile(q float64) time.Duration {
+	total := h.Count()
+	if total == 0 {
+		return 0
+	}
+
+	rank := q * float64(total)
+	var seen int64
+	for i, c := range h {
+		if c == 0 || float64(seen+c) < rank {
+			seen += c
+			continue
+		}
+		if i == len(Bounds) {
+			return Bounds[len(Bounds)-1]
+		}
+		var lower time.Duration
+		if i > 0 {
+			lower = Bounds[i-1]
+		}
+		frac := (rank - float64(seen)) / float64(c)
+		return lower + time.Duration(frac*float64(Bounds[i]-lower))
+	}
+	return Bounds[len(Bounds)-1]
+}
+
+func (h Histogram) String() string {
+	parts := make([]string, len(h))
+	for i, c := range h {
+		parts[i] = strconv.FormatInt(c, 10)
+	}
+	return strings.Join(parts, ",")
+}
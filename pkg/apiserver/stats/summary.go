@@ -0,0 +1,79 @@
+package stats
+
+import (
+	"time"
+
+	"big-infra/pkg/apiserver/common"
+	"big-infra/pkg/model"
+)
+
+type summaryKey struct {
+	tenant, day, status, subject, applyer, reviewer string
+}
+
+// Summarizer builds the summary rows of the applies added to it, as of a time
+type Summarizer struct {
+	now  time.Time
+	loc  *time.Location
+	rows map[summaryKey]*model.ApplyStat
+	hist map[summaryKey]Histogram
+}
+
+// NewSummarizer news a Summarizer, the days of the summary are in loc
+func NewSummarizer(now time.Time, loc *time.Location) *Summarizer {
+	return &Summarizer{
+		now:  now,
+		loc:  loc,
+		rows: make(map[summaryKey]*model.ApplyStat),
+		hist: make(map[summaryKey]Histogram),
+	}
+}
+
+// Add counts ia. The applies created before their creation time was recorded have no day and are
+// left out.
+func (s *Summarizer) Add(ia *model.InfraApply) {
+	if ia.CreatedAt.IsZero() {
+		return
+	}
+
+	k := summaryKey{ia.TenantID, DayOf(ia.CreatedAt, s.loc), ia.Status, ia.SubjectName, ia.Applyer, ia.ReviewId}
+	row, ok := s.rows[k]
+	if !ok {
+		row = &model.ApplyStat{
+			TenantID:    k.tenant,
+			Day:         k.day,
+			Status:      k.status,
+			SubjectName: k.subject,
+			Applyer:     k.applyer,
+			Reviewer:    k.reviewer,
+			RefreshedAt: s.now,
+		}
+		s.rows[k] = row
+		s.hist[k] = NewHistogram()
+	}
+
+	row.Total++
+	// an extension is counted in the grant it extends
+	if ia.Status == common.STATUS_APPROVED && ia.ExtendsID == 0 &&
+		(ia.ExpiresAt.IsZero() || ia.ExpiresAt.After(s.now)) {
+		row.Active++
+	}
+	if ia.Status != common.STATUS_INIT && !ia.ReviewedAt.IsZero() {
+		latency := ia.ReviewedAt.Sub(ia.CreatedAt)
+		if latency < 0 {
+			latency = 0
+		}
+		row.Reviewed++
+		s.hist[k].Add(latency)
+	}
+}
+
+// Rows returns the summary rows
+func (s *Summarizer) Rows() []model.ApplyStat {
+	res := make([]model.ApplyStat, 0, len(s.rows))
+	for k, row := range s.rows {
+		row.LatencyHist = s.hist[k].String()
+		res = append(res, *row)
+	}
+	return res
+}
@@ -2,8 +2,15 @@ package test
 
 import (
 	"context"
+	"os"
+	"testing"
+	"time"
 
 	v1 "big-infra/pkg/apiserver/api/v1"
+	v2 "big-infra/pkg/apiserver/api/v2"
+
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/metadata"
 )
 
 var InfraCli *InfraGrpcClient
@@ -12,7 +19,45 @@ const (
 	grpcAddr = "127.0.0.1:5000"
 )
 
+var (
+	// authSecret is the Identify.AuthSecret of the server under test, it signs the tokens of asUser
+	authSecret = os.Getenv("INFRA_AUTH_SECRET")
+	// adminUid is one of the Identify.Admins of the server under test
+	adminUid = os.Getenv("INFRA_ADMIN_UID")
+)
+
 type InfraGrpcClient struct {
 	cli v1.INFRAAPPLYClient
+	dev v1.DEVICEClient
+	sub v1.SUBJECTClient
+	v2  v2.INFRAAPPLYClient
 	ctx context.Context
 }
+
+// asUser returns the context of the calls authenticated as uid, the test is skipped when
+// INFRA_AUTH_SECRET is not set
+func asUser(t *testing.T, uid string) context.Context {
+	t.Helper()
+	if authSecret == "" {
+		t.Skip("INFRA_AUTH_SECRET is not set")
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"uid": uid,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(authSecret))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// asAdmin returns the context of the calls authenticated as adminUid, the test is skipped when
+// INFRA_AUTH_SECRET or INFRA_ADMIN_UID is not set
+func asAdmin(t *testing.T) context.Context {
+	t.Helper()
+	if adminUid == "" {
+		t.Skip("INFRA_ADMIN_UID is not set")
+	}
+	return asUser(t, adminUid)
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	v1 "big-infra/pkg/apiserver/api/v1"
+	v2 "big-infra/pkg/apiserver/api/v2"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -24,6 +25,9 @@ func TestMain(m *testing.M) {
 
 	InfraCli = &InfraGrpcClient{
 		cli: client,
+		dev: v1.NewDEVICEClient(conn),
+		sub: v1.NewSUBJECTClient(conn),
+		v2:  v2.NewINFRAAPPLYClient(conn),
 		ctx: ctx,
 	}
 	os.Exit(m.Run())
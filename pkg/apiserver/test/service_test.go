@@ -2,7 +2,17 @@ package test
 
 import (
 	v1 "big-infra/pkg/apiserver/api/v1"
+	v2 "big-infra/pkg/apiserver/api/v2"
+	"context"
+	"github.com/google/uuid"
 	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"math"
+	"strconv"
+	"sync"
 	"testing"
 )
 
@@ -23,9 +33,571 @@ func TestUpdateInfraApply(t *testing.T) {
 		ID:     13,
 		Status: "审批完成",
 	}
-	resp, err := InfraCli.cli.UpdateInfraApply(InfraCli.ctx, &req)
+	resp, err := InfraCli.cli.UpdateInfraApply(asUser(t, "reviewer"), &req)
 	if err != nil {
 		t.Error(err.Error())
 	}
 	logger.Infof("%+v", resp)
 }
+
+func TestAddDevice(t *testing.T) {
+	req := v1.AddDeviceReq{
+		Code:        "dev-001",
+		Name:        "test device",
+		Owner:       "owner",
+		Environment: "test",
+		Tags:        []string{"linux", "ssh"},
+	}
+	_, err := InfraCli.dev.AddDevice(asAdmin(t), &req)
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		t.Fatal(err.Error())
+	}
+
+	resp, err := InfraCli.dev.ListDevice(InfraCli.ctx, &v1.ListDeviceReq{PageIdx: 1, PageSize: 10, Tag: "ssh"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Record) == 0 {
+		t.Error("device not found by tag")
+	}
+	_, err = InfraCli.dev.ListDevice(InfraCli.ctx, &v1.ListDeviceReq{PageIdx: 1, PageSize: -1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("list devices without page size limit: %v", err)
+	}
+
+	_, err = InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "no-such-device",
+		SubjectName: "ssh",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("apply for an unknown device: %v", err)
+	}
+}
+
+func TestAddSubject(t *testing.T) {
+	req := v1.AddSubjectReq{
+		Name:               "ssh-short",
+		Description:        "ssh login limited to one hour",
+		Owners:             []string{"owner"},
+		MaxDurationSec:     3600,
+		DefaultDurationSec: 1800,
+		RiskLevel:          "high",
+	}
+	_, err := InfraCli.sub.AddSubject(asAdmin(t), &req)
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		t.Fatal(err.Error())
+	}
+
+	_, err = InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh-short",
+		ExpireTM:    "2030-01-02 15:04:05",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("apply longer than the subject maximum: %v", err)
+	}
+}
+
+func TestAddInfraApplyIdempotent(t *testing.T) {
+	req := v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+		ExpireTM:    "2030-01-02 15:04:05",
+	}
+	_, err := InfraCli.cli.AddInfraApply(InfraCli.ctx, &req)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("apply anonymously: %v", err)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(asUser(t, "tester"), "idempotency-key", uuid.New().String())
+	first, err := InfraCli.cli.AddInfraApply(ctx, &req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got, err := InfraCli.cli.GetInfraApply(InfraCli.ctx, &v1.GetInfraApplyReq{ID: first.ID}); err != nil {
+		t.Fatal(err.Error())
+	} else if got.Applyer != "tester" {
+		t.Errorf("got apply of %s, want the caller", got.Applyer)
+	}
+	retry, err := InfraCli.cli.AddInfraApply(ctx, &req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if first.ID != retry.ID {
+		t.Errorf("retry created a new apply: %d != %d", first.ID, retry.ID)
+	}
+
+	req.SubjectName = "rdp"
+	_, err = InfraCli.cli.AddInfraApply(ctx, &req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("reused key with another payload: %v", err)
+	}
+}
+
+func TestExtendInfraApply(t *testing.T) {
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+		ExpireTM:    "2030-01-02 15:04:05",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the uid of the request is not the caller
+	req := v1.ExtendInfraApplyReq{
+		ID:       apply.ID,
+		Uid:      "tester",
+		ExpireTM: "2030-02-02 15:04:05",
+	}
+	_, err = InfraCli.cli.ExtendInfraApply(InfraCli.ctx, &req)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("extend anonymously: %v", err)
+	}
+	_, err = InfraCli.cli.ExtendInfraApply(asUser(t, "someone-else"), &req)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("extend the grant of another user: %v", err)
+	}
+
+	resp, err := InfraCli.cli.ListInfraApply(InfraCli.ctx, &v1.ListInfraApplyReq{
+		PageIdx:   1,
+		PageSize:  10,
+		ExtendsID: apply.ID,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	logger.Infof("%+v", resp)
+}
+
+func TestApproveOwnExtension(t *testing.T) {
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+		ExpireTM:    "2030-01-02 15:04:05",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	applyer, reviewer := asUser(t, "tester"), asUser(t, "reviewer")
+	_, err = InfraCli.cli.UpdateInfraApply(applyer, &v1.UpdateInfraApplyReq{ID: apply.ID, Status: "approved"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("approve the own apply: %v", err)
+	}
+	if _, err = InfraCli.cli.UpdateInfraApply(reviewer, &v1.UpdateInfraApplyReq{ID: apply.ID, Status: "approved"}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ext, err := InfraCli.cli.ExtendInfraApply(applyer, &v1.ExtendInfraApplyReq{
+		ID:       apply.ID,
+		ExpireTM: "2030-02-02 15:04:05",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if ext.Status != "init" {
+		t.Skipf("extension decided by policy rule %s", ext.PolicyRule)
+	}
+
+	for _, c := range []struct {
+		ctx  context.Context
+		want codes.Code
+	}{
+		{InfraCli.ctx, codes.Unauthenticated},
+		{applyer, codes.PermissionDenied},
+	} {
+		_, err = InfraCli.cli.UpdateInfraApply(c.ctx, &v1.UpdateInfraApplyReq{ID: ext.ID, Status: "approved"})
+		if status.Code(err) != c.want {
+			t.Errorf("approve the extension: %v, want %s", err, c.want)
+		}
+	}
+
+	got, err := InfraCli.cli.GetInfraApply(InfraCli.ctx, &v1.GetInfraApplyReq{ID: apply.ID})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got.ExpireTM != "2030-01-02 15:04:05" {
+		t.Errorf("grant expires at %s before its extension is approved", got.ExpireTM)
+	}
+}
+
+func TestGetInfraApplyV2(t *testing.T) {
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+		ExpireTM:    "2030-01-02 15:04:05",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	resp, err := InfraCli.v2.GetInfraApply(InfraCli.ctx, &v2.GetInfraApplyReq{ID: apply.ID})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resp.ExpireTime == nil {
+		t.Error("expireTime is not set")
+	}
+	if resp.Status == "init" && resp.ReviewTime != nil {
+		t.Errorf("unreviewed apply has reviewTime %v", resp.ReviewTime)
+	}
+
+	// the v1 string times can be written back as they are read
+	list, err := InfraCli.cli.ListInfraApply(InfraCli.ctx, &v1.ListInfraApplyReq{PageIdx: 1, PageSize: -1})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, rec := range list.Record {
+		if rec.ID == apply.ID && rec.ExpireTM != "2030-01-02 15:04:05" {
+			t.Errorf("expireTM %q does not round trip", rec.ExpireTM)
+		}
+	}
+}
+
+func TestDelInfraApply(t *testing.T) {
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	got, err := InfraCli.cli.GetInfraApply(InfraCli.ctx, &v1.GetInfraApplyReq{ID: apply.ID})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got.Applyer != "tester" {
+		t.Errorf("got apply of %s", got.Applyer)
+	}
+
+	// the uid of the request is not the caller
+	req := v1.DelInfraApplyReq{ID: strconv.Itoa(int(apply.ID)), Uid: "tester"}
+	_, err = InfraCli.cli.DelInfraApply(InfraCli.ctx, &req)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("withdraw anonymously: %v", err)
+	}
+	_, err = InfraCli.cli.DelInfraApply(asUser(t, "someone-else"), &req)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("withdraw the apply of another user: %v", err)
+	}
+	if _, err = InfraCli.cli.DelInfraApply(asUser(t, "tester"), &req); err != nil {
+		t.Errorf("withdraw by the applyer: %v", err)
+	}
+}
+
+func TestBatchUpdateInfraApply(t *testing.T) {
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the missing apply is rejected, the other one still reports its own result once
+	missing := int32(math.MaxInt32)
+	req := v1.BatchUpdateInfraApplyReq{
+		IDs:        []int32{apply.ID, missing, apply.ID},
+		Status:     "approved",
+		Comment:    "batch approved",
+		BestEffort: true,
+		DryRun:     true,
+	}
+	reviewer := asUser(t, "reviewer")
+	resp, err := InfraCli.cli.BatchUpdateInfraApply(reviewer, &req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(resp.Items) != 2 {
+		t.Fatalf("got %d items for 2 applies", len(resp.Items))
+	}
+	if item := resp.Items[0]; item.ID != apply.ID || item.Result != "success" || item.Error != "" {
+		t.Errorf("apply %d: %+v", apply.ID, item)
+	}
+	if item := resp.Items[1]; item.ID != missing || item.Result != "failed" || item.Error == "" {
+		t.Errorf("missing apply %d: %+v", missing, item)
+	}
+	if resp.Succeeded != 1 || resp.Failed != 1 || resp.Result != "failed" {
+		t.Errorf("got %d succeeded, %d failed, result %s", resp.Succeeded, resp.Failed, resp.Result)
+	}
+
+	// nothing is saved by a dry run, nor by a batch rolled back on its failure
+	req.BestEffort, req.DryRun = false, false
+	_, err = InfraCli.cli.BatchUpdateInfraApply(reviewer, &req)
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("batch with a missing apply: %v", err)
+	}
+	got, err := InfraCli.cli.GetInfraApply(InfraCli.ctx, &v1.GetInfraApplyReq{ID: apply.ID})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got.Status != apply.Status {
+		t.Errorf("got status %s after the batches, want %s", got.Status, apply.Status)
+	}
+}
+
+func TestSaveWorkflow(t *testing.T) {
+	req := v1.SaveWorkflowReq{
+		Name: "owner-then-security",
+		Stages: []*v1.WorkflowStage{
+			{Seq: 1, Name: "owner", Reviewers: []string{"owner"}, Quorum: 1},
+			{Seq: 2, Name: "security", Reviewers: []string{"sec-a", "sec-b", "sec-c"}, Quorum: 2},
+		},
+		Subjects: []string{"prod-db"},
+	}
+	_, err := InfraCli.cli.SaveWorkflow(InfraCli.ctx, &req)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("save workflow anonymously: %v", err)
+	}
+	_, err = InfraCli.cli.SaveWorkflow(asUser(t, "tester"), &req)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("save workflow by a user who is not admin: %v", err)
+	}
+	wf, err := InfraCli.cli.SaveWorkflow(asAdmin(t), &req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "prod-db",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// review approves the apply as uid, then checks the stage and the status it is left in
+	review := func(uid string, want codes.Code, wantStage int32, wantStatus string) {
+		t.Helper()
+		resp, err := InfraCli.cli.UpdateInfraApply(asUser(t, uid), &v1.UpdateInfraApplyReq{
+			ID:     apply.ID,
+			Status: "approved",
+		})
+		if status.Code(err) != want {
+			t.Fatalf("review of %s: %v, want %s", uid, err, want)
+		}
+		if err == nil && resp.Status != wantStatus {
+			t.Errorf("review of %s: got status %s, want %s", uid, resp.Status, wantStatus)
+		}
+
+		got, err := InfraCli.cli.GetInfraApply(InfraCli.ctx, &v1.GetInfraApplyReq{ID: apply.ID})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got.WorkflowID != wf.ID || got.Stage != wantStage || got.Status != wantStatus {
+			t.Errorf("after the review of %s: workflow %d stage %d status %s, want %d %d %s",
+				uid, got.WorkflowID, got.Stage, got.Status, wf.ID, wantStage, wantStatus)
+		}
+	}
+
+	review("sec-a", codes.PermissionDenied, 1, "init")
+	review("owner", codes.OK, 2, "init")
+	review("owner", codes.PermissionDenied, 2, "init")
+	// the security stage needs 2 of its 3 reviewers
+	review("sec-a", codes.OK, 2, "init")
+	review("sec-a", codes.AlreadyExists, 2, "init")
+	review("sec-b", codes.OK, 2, "approved")
+
+	reviews, err := InfraCli.cli.ListInfraApplyReview(InfraCli.ctx, &v1.ListInfraApplyReviewReq{ID: apply.ID})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(reviews.Record) != 3 {
+		t.Errorf("got %d reviews, want 3", len(reviews.Record))
+	}
+}
+
+func TestSimulatePolicy(t *testing.T) {
+	req := v1.SimulatePolicyReq{
+		DeviceCode:  "dev-001",
+		Uid:         "tester",
+		SubjectName: "ssh",
+		ExpireTM:    "2030-01-02 15:04:05",
+		EvalTM:      "2030-01-01 10:00:00",
+	}
+	resp, err := InfraCli.cli.SimulatePolicy(InfraCli.ctx, &req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the rule that fired is the first one matched
+	var first string
+	for _, r := range resp.Results {
+		if r.Matched && first == "" {
+			first = r.Name
+		}
+	}
+	if resp.Rule != first {
+		t.Errorf("got rule %q, the first matched is %q", resp.Rule, first)
+	}
+	if resp.Rule == "" && (resp.Action != "" || resp.Workflow != "") {
+		t.Errorf("got action %q workflow %q without a rule", resp.Action, resp.Workflow)
+	}
+	if resp.Rule != "" && resp.Action == "" {
+		t.Errorf("rule %s fired without action", resp.Rule)
+	}
+
+	req.EvalTM = "tomorrow"
+	_, err = InfraCli.cli.SimulatePolicy(InfraCli.ctx, &req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("simulate at a bad time: %v", err)
+	}
+}
+
+func TestSearchInfraApply(t *testing.T) {
+	req := v1.SearchInfraApplyReq{
+		Query:    "tester ssh*",
+		PageIdx:  1,
+		PageSize: 10,
+	}
+	resp, err := InfraCli.cli.SearchInfraApply(InfraCli.ctx, &req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, hit := range resp.Hit {
+		if len(hit.Highlights) == 0 {
+			t.Errorf("apply %d found without highlight", hit.Apply.ID)
+		}
+	}
+
+	_, err = InfraCli.cli.SearchInfraApply(InfraCli.ctx, &v1.SearchInfraApplyReq{Query: "-ssh"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("search without word to match: %v", err)
+	}
+}
+
+func TestGetInfraApplyStats(t *testing.T) {
+	req := v1.GetInfraApplyStatsReq{
+		GroupBy: []string{"subject", "status"},
+		Bucket:  "week",
+	}
+	resp, err := InfraCli.cli.GetInfraApplyStats(InfraCli.ctx, &req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	logger.Infof("%+v", resp)
+
+	_, err = InfraCli.cli.GetInfraApplyStats(InfraCli.ctx, &v1.GetInfraApplyStatsReq{Bucket: "year"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("stats by an unknown bucket: %v", err)
+	}
+}
+
+func TestWithdrawWhileReviewed(t *testing.T) {
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	applyer, reviewer := asUser(t, "tester"), asUser(t, "reviewer")
+	var wg sync.WaitGroup
+	var reviewErr, withdrawErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, reviewErr = InfraCli.cli.UpdateInfraApply(reviewer, &v1.UpdateInfraApplyReq{
+			ID:     apply.ID,
+			Status: "approved",
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		_, withdrawErr = InfraCli.cli.DelInfraApply(applyer, &v1.DelInfraApplyReq{
+			ID: strconv.Itoa(int(apply.ID)),
+		})
+	}()
+	wg.Wait()
+
+	if reviewErr == nil && withdrawErr == nil {
+		t.Error("apply both reviewed and withdrawn")
+	}
+	logger.Infof("review: %v, withdraw: %v", reviewErr, withdrawErr)
+}
+
+func TestReadYourWrites(t *testing.T) {
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// pinned to the primary by the write, and asked for it
+	for _, ctx := range []context.Context{
+		InfraCli.ctx,
+		metadata.AppendToOutgoingContext(InfraCli.ctx, "read-primary", "true"),
+	} {
+		got, err := InfraCli.cli.GetInfraApply(ctx, &v1.GetInfraApplyReq{ID: apply.ID})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got.ID != apply.ID {
+			t.Errorf("got apply %d for %d", got.ID, apply.ID)
+		}
+	}
+}
+
+func TestCachedInfraApply(t *testing.T) {
+	apply, err := InfraCli.cli.AddInfraApply(asUser(t, "tester"), &v1.AddInfraApplyReq{
+		DeviceCode:  "dev-001",
+		SubjectName: "ssh",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := InfraCli.cli.GetInfraApply(InfraCli.ctx, &v1.GetInfraApplyReq{ID: apply.ID}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	resp, err := InfraCli.cli.UpdateInfraApply(asUser(t, "reviewer"), &v1.UpdateInfraApplyReq{ID: apply.ID, Status: "approved"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// the review invalidates the cached apply, with or without the bypass header
+	for _, ctx := range []context.Context{
+		InfraCli.ctx,
+		metadata.AppendToOutgoingContext(InfraCli.ctx, "cache-bypass", "true"),
+	} {
+		got, err := InfraCli.cli.GetInfraApply(ctx, &v1.GetInfraApplyReq{ID: apply.ID})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if got.Status != resp.Status {
+			t.Errorf("got status %s after the review, want %s", got.Status, resp.Status)
+		}
+	}
+}
+
+func TestImportInfraApplyNotAdmin(t *testing.T) {
+	importAs := func(ctx context.Context) error {
+		stream, err := InfraCli.cli.ImportInfraApply(ctx)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&v1.ImportInfraApplyReq{Row: &v1.ImportInfraApplyRow{
+			DeviceCode:  "dev-001",
+			Uid:         "tester",
+			SubjectName: "ssh",
+			Status:      "approved",
+		}})
+		if err != nil && err != io.EOF {
+			return err
+		}
+		_, err = stream.CloseAndRecv()
+		return err
+	}
+
+	if err := importAs(InfraCli.ctx); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("import anonymously: %v", err)
+	}
+	if err := importAs(asUser(t, "tester")); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("import by a user who is not admin: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"strings"
+	"sync"
+)
+
+// UnixPrefix marks a listener address as a unix socket path, like unix:/run/apiserver.sock
+const UnixPrefix = "unix:"
+
+// SplitAddress returns the network and the address of a listener address
+func SplitAddress(address string) (network, addr string) {
+	if strings.HasPrefix(address, UnixPrefix) {
+		return "unix", strings.TrimPrefix(strings.TrimPrefix(address, UnixPrefix), "//")
+	}
+	return "tcp", address
+}
+
+// Listen listens on a tcp or unix address, a socket file left by a previous run is removed
+func Listen(address string) (net.Listener, error) {
+	network, addr := SplitAddress(address)
+	if network == "unix" {
+		if fi, err := os.Stat(addr); err == nil && fi.Mode()&os.ModeSocket != 0 {
+			if err := os.Remove(addr); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return net.Listen(network, addr)
+}
+
+// DialAddress returns the address a local client reaches a listener address at,
+// an unspecified tcp host like :5000 or 0.0.0.0:5000 is dialed on localhost
+func DialAddress(address string) (network, addr string, err error) {
+	network, addr = SplitAddress(address)
+	if network == "unix" {
+		return network, addr, nil
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", "", err
+	}
+	if host == "" || net.ParseIP(host) != nil && net.ParseIP(host).IsUnspecified() {
+		host = "localhost"
+	}
+	return network, net.JoinHostPort(host, port), nil
+}
+
+var errPipeClosed = errors.New("pipe listener closed")
+
+// PipeListener is an in-memory listener, its connections are made by DialContext
+// without going through the network
+type PipeListener struct {
+	conns chan net.Conn
+	once  sync.Once
+	done  chan struct{}
+}
+
+func NewPipeListener() *PipeListener {
+	return &PipeListener{
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+func (l *PipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, errPipeClosed
+	}
+}
+
+func (l *PipeListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+	return nil
+}
+
+func (l *PipeListener) Addr() net.Addr {
+	return pipeAddr{}
+}
+
+// DialContext connects to the listener, the address is ignored
+func (l *PipeListener) DialContext(ctx context.Context, _ string) (net.Conn, error) {
+	server, client := net.Pipe()
+	select {
+	case l.conns <- server:
+		return client, nil
+	case <-l.done:
+		return nil, errPipeClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return "pipe" }
+func (pipeAddr) String() string  { return "pipe" }
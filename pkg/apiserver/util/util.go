@@ -2,6 +2,34 @@ package util
 
 import "time"
 
+// TimeLayout is the layout of the string times of the v1 api
+const TimeLayout = "2006-01-02 15:04:05"
+
+// Location is the server timezone, string times are read and written in it
+var Location = time.Local
+
+// SetLocation sets the server timezone by its IANA name, empty keeps the system timezone
+func SetLocation(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return err
+	}
+	Location = loc
+	return nil
+}
+
 func StrToTime(strTime string) (time.Time, error) {
-	return time.ParseInLocation("2006-01-02 15:04:05", strTime, time.Local)
-}
\ No newline at end of file
+	return time.ParseInLocation(TimeLayout, strTime, Location)
+}
+
+// TimeToStr formats t so StrToTime can read it back, a zero t is ""
+func TimeToStr(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(Location).Format(TimeLayout)
+}
@@ -0,0 +1,113 @@
+package util
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"io"
+)
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbookHead = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`
+	xlsxWorkbookTail = `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxSheetHead    = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetTail = `</sheetData></worksheet>`
+)
+
+// XLSXWriter writes a single sheet workbook row by row, so rows are never held in memory.
+// All cells are written as inline strings.
+type XLSXWriter struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+}
+
+// NewXLSXWriter writes the workbook parts that come before the rows to w
+func NewXLSXWriter(w io.Writer, sheetName string) (*XLSXWriter, error) {
+	zw := zip.NewWriter(w)
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := zw.Create("xl/workbook.xml")
+	if err != nil {
+		return nil, err
+	}
+	io.WriteString(f, xlsxWorkbookHead)
+	xml.EscapeText(f, []byte(sheetName))
+	if _, err := io.WriteString(f, xlsxWorkbookTail); err != nil {
+		return nil, err
+	}
+
+	f, err = zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(xlsxSheetHead); err != nil {
+		return nil, err
+	}
+
+	return &XLSXWriter{zw: zw, sheet: sheet}, nil
+}
+
+// Write writes a row of cells
+func (x *XLSXWriter) Write(row []string) error {
+	x.sheet.WriteString("<row>")
+	for _, cell := range row {
+		x.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+		if err := xml.EscapeText(x.sheet, []byte(cell)); err != nil {
+			return err
+		}
+		x.sheet.WriteString("</t></is></c>")
+	}
+	_, err := x.sheet.WriteString("</row>")
+	return err
+}
+
+// Flush writes the buffered rows to the underlying writer
+func (x *XLSXWriter) Flush() error {
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.zw.Flush()
+}
+
+// Close finishes the sheet and the zip archive, it does not close the underlying writer
+func (x *XLSXWriter) Close() error {
+	if _, err := x.sheet.WriteString(xlsxSheetTail); err != nil {
+		return err
+	}
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.zw.Close()
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "big-infra/pkg/apiserver/api/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const usage = `infractl is the command line client of the apiserver
+
+Usage:
+  infractl import [flags] <file.csv|file.json>
+
+Run "infractl <command> -h" for the flags of a command.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "infractl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runImport streams the rows of a csv or json file to ImportInfraApply
+//
+// A csv file has a header line naming its columns, a json file is an array of objects.
+// Both use the field names of ImportInfraApplyRow: deviceCode, uid, subjectName, status,
+// expireTM, reviewId, reviewTM and comment.
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	addr := fs.String("addr", "127.0.0.1:5000", "grpc address of the apiserver")
+	token := fs.String("token", os.Getenv("INFRA_TOKEN"), "bearer token, defaults to $INFRA_TOKEN")
+	format := fs.String("format", "", "csv or json, guessed from the file extension if empty")
+	dryRun := fs.Bool("dry-run", false, "validate the rows without saving them")
+	upsert := fs.Bool("upsert", false, "update the apply with the same uid, deviceCode and subjectName")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return errors.New("import needs exactly one file")
+	}
+	path := fs.Arg(0)
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	conn, err := grpc.Dial(*addr, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	}
+
+	stream, err := v1.NewINFRAAPPLYClient(conn).ImportInfraApply(ctx)
+	if err != nil {
+		return err
+	}
+
+	first := true
+	send := func(row *v1.ImportInfraApplyRow) error {
+		req := v1.ImportInfraApplyReq{Row: row}
+		if first {
+			req.DryRun, req.Upsert, first = *dryRun, *upsert, false
+		}
+		return stream.Send(&req)
+	}
+
+	switch *format {
+	case "csv":
+		err = readCSVRows(f, send)
+	case "json":
+		err = readJSONRows(f, send)
+	default:
+		err = fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		stream.CloseSend()
+		return err
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("batch %s: %d rows, %d created, %d updated, %d failed\n",
+		resp.BatchID, resp.Total, resp.Created, resp.Updated, resp.Failed)
+	for _, e := range resp.Errors {
+		fmt.Printf("  line %d: %s\n", e.Line, e.Error)
+	}
+	if *dryRun {
+		fmt.Println("dry run, nothing was saved")
+	}
+	if resp.Failed > 0 {
+		return fmt.Errorf("%d rows failed", resp.Failed)
+	}
+	return nil
+}
+
+// readCSVRows sends the rows of a csv file, lines are counted from the header line
+func readCSVRows(r io.Reader, send func(*v1.ImportInfraApplyRow) error) error {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return err
+	}
+
+	for line := int32(2); ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		fields := make(map[string]string, len(header))
+		for i, name := range header {
+			if i < len(record) {
+				fields[strings.TrimSpace(name)] = record[i]
+			}
+		}
+
+		row := v1.ImportInfraApplyRow{
+			Line:        line,
+			DeviceCode:  fields["deviceCode"],
+			Uid:         fields["uid"],
+			SubjectName: fields["subjectName"],
+			Status:      fields["status"],
+			ExpireTM:    fields["expireTM"],
+			ReviewId:    fields["reviewId"],
+			ReviewTM:    fields["reviewTM"],
+			Comment:     fields["comment"],
+		}
+		if err := send(&row); err != nil {
+			return err
+		}
+	}
+}
+
+// readJSONRows sends the objects of a json array one by one, lines are counted from 1
+func readJSONRows(r io.Reader, send func(*v1.ImportInfraApplyRow) error) error {
+	dec := json.NewDecoder(r)
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	for line := int32(1); dec.More(); line++ {
+		var row v1.ImportInfraApplyRow
+		if err := dec.Decode(&row); err != nil {
+			return err
+		}
+		row.Line = line
+		if err := send(&row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
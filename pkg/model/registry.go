@@ -0,0 +1,61 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Model is a row of a table
+type Model interface {
+	TableName() string
+}
+
+// Table is a registered table
+type Table struct {
+	Name   string
+	Tenant bool // the table has tenant_id, read from the TenantID field of its model
+}
+
+var (
+	_tables  = map[string]*Table{}
+	_byModel = map[reflect.Type]*Table{}
+)
+
+// Register adds the tables of models to the registry, a model registers itself from an init next
+// to its TableName. Registering a table twice panics.
+func Register(models ...Model) {
+	for _, m := range models {
+		name := m.TableName()
+		if _, ok := _tables[name]; ok {
+			panic(fmt.Sprintf("model: table %s registered twice", name))
+		}
+
+		typ := reflect.TypeOf(m)
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		_, tenant := typ.FieldByName("TenantID")
+
+		t := &Table{Name: name, Tenant: tenant}
+		_tables[name] = t
+		_byModel[typ] = t
+	}
+}
+
+// TableOf returns the table of a registered model, m is the model or a pointer to it
+func TableOf(m interface{}) (*Table, error) {
+	typ := reflect.TypeOf(m)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	t, ok := _byModel[typ]
+	if !ok {
+		return nil, fmt.Errorf("model: %T is not registered", m)
+	}
+	return t, nil
+}
+
+// LookupTable returns the registered table called name, nil if there is none
+func LookupTable(name string) *Table {
+	return _tables[name]
+}
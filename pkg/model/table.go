@@ -1,10 +1,14 @@
 package model
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // InfraApply
 type InfraApply struct {
 	ID          int32     `gorm:"primary_key"`
+	TenantID    string    `gorm:"column:tenant_id"` // every table has it, queries through common.WithTenant are scoped to it
 	DeviceCode  string    `gorm:"column:device_code"`
 	Applyer     string    `gorm:"column:applyer"`
 	Status      string    `gorm:"column:status"` // init|refused|approved|expired
@@ -12,9 +16,284 @@ type InfraApply struct {
 	ReviewId    string    `gorm:"column:review_id"`
 	ExpiresAt   time.Time `gorm:"column:expires_at"`
 	ReviewedAt  time.Time `gorm:"column:review_at"`
+	Comment     string    `gorm:"column:comment"`
+	WorkflowID  int32     `gorm:"column:workflow_id"` // 0 if a single review decides the apply
+	Stage       int32     `gorm:"column:stage"`       // sequence of the workflow stage waiting for review
+	PolicyRule  string    `gorm:"column:policy_rule"` // the policy rule that decided or routed the apply
+	ExtendsID   int32     `gorm:"column:extends_id"`  // the grant an extension request extends, 0 for a new apply
+	CreatedAt   time.Time `gorm:"column:created_at"`
 }
 
 // TableName is the getter for tables' names
 func (c *InfraApply) TableName() string {
 	return "t_subject_apply"
 }
+
+func init() {
+	Register(&InfraApply{})
+}
+
+// Device is an entry of the device inventory, InfraApply.DeviceCode refers to its Code
+type Device struct {
+	ID          int32     `gorm:"primary_key"`
+	TenantID    string    `gorm:"column:tenant_id"`
+	Code        string    `gorm:"column:code"`
+	Name        string    `gorm:"column:name"`
+	Owner       string    `gorm:"column:owner"`
+	Environment string    `gorm:"column:environment"` // prod|staging|dev...
+	Tags        string    `gorm:"column:tags"`        // comma separated
+	State       string    `gorm:"column:state"`       // active|maintenance|decommissioned
+	CreatedAt   time.Time `gorm:"column:created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+}
+
+// TableName is the getter for tables' names
+func (c *Device) TableName() string {
+	return "t_device"
+}
+
+func init() {
+	Register(&Device{})
+}
+
+// TagList splits Tags
+func (c *Device) TagList() []string {
+	return splitList(c.Tags)
+}
+
+// Subject is an entry of the catalog of what can be applied for
+type Subject struct {
+	ID              int32     `gorm:"primary_key"`
+	TenantID        string    `gorm:"column:tenant_id"`
+	Name            string    `gorm:"column:name"`
+	Description     string    `gorm:"column:description"`
+	Owners          string    `gorm:"column:owners"`           // comma separated uids
+	MaxDuration     int64     `gorm:"column:max_duration"`     // seconds, 0 means unlimited
+	DefaultDuration int64     `gorm:"column:default_duration"` // seconds, 0 means never expire
+	RiskLevel       string    `gorm:"column:risk_level"`       // low|medium|high|critical
+	Workflow        string    `gorm:"column:workflow"`         // name of the required workflow
+	CreatedAt       time.Time `gorm:"column:created_at"`
+	UpdatedAt       time.Time `gorm:"column:updated_at"`
+}
+
+// TableName is the getter for tables' names
+func (c *Subject) TableName() string {
+	return "t_subject"
+}
+
+func init() {
+	Register(&Subject{})
+}
+
+// OwnerList splits Owners into uids
+func (c *Subject) OwnerList() []string {
+	return splitList(c.Owners)
+}
+
+// InfraApplyReview is one reviewer decision on an apply
+type InfraApplyReview struct {
+	ID        int32     `gorm:"primary_key"`
+	TenantID  string    `gorm:"column:tenant_id"`
+	ApplyID   int32     `gorm:"column:apply_id"`
+	Stage     int32     `gorm:"column:stage"`
+	Reviewer  string    `gorm:"column:reviewer"`
+	Decision  string    `gorm:"column:decision"` // approved|refused, or any status for applies without workflow
+	Comment   string    `gorm:"column:comment"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// TableName is the getter for tables' names
+func (c *InfraApplyReview) TableName() string {
+	return "t_subject_apply_review"
+}
+
+func init() {
+	Register(&InfraApplyReview{})
+}
+
+// Workflow is a chain of review stages. A saved workflow is never changed, saving it again
+// creates a new version so applies in flight keep the stages they started with.
+type Workflow struct {
+	ID          int32     `gorm:"primary_key"`
+	TenantID    string    `gorm:"column:tenant_id"`
+	Name        string    `gorm:"column:name"`
+	Description string    `gorm:"column:description"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+// TableName is the getter for tables' names
+func (c *Workflow) TableName() string {
+	return "t_workflow"
+}
+
+func init() {
+	Register(&Workflow{})
+}
+
+// WorkflowStage is a stage of a workflow. Stages run in the order of Seq, stages sharing
+// the same Seq run in parallel and all of them must reach their quorum.
+type WorkflowStage struct {
+	ID         int32  `gorm:"primary_key"`
+	TenantID   string `gorm:"column:tenant_id"`
+	WorkflowID int32  `gorm:"column:workflow_id"`
+	Seq        int32  `gorm:"column:seq"` // begin index is 1
+	Name       string `gorm:"column:name"`
+	Reviewers  string `gorm:"column:reviewers"` // comma separated uids
+	Quorum     int32  `gorm:"column:quorum"`    // approvals needed to pass the stage
+}
+
+// TableName is the getter for tables' names
+func (c *WorkflowStage) TableName() string {
+	return "t_workflow_stage"
+}
+
+func init() {
+	Register(&WorkflowStage{})
+}
+
+// ReviewerList splits Reviewers into uids
+func (c *WorkflowStage) ReviewerList() []string {
+	return splitList(c.Reviewers)
+}
+
+// SubjectWorkflow attaches a workflow to a subject
+type SubjectWorkflow struct {
+	TenantID    string `gorm:"column:tenant_id;primary_key"`
+	SubjectName string `gorm:"column:subject_name;primary_key"`
+	WorkflowID  int32  `gorm:"column:workflow_id"`
+}
+
+// TableName is the getter for tables' names
+func (c *SubjectWorkflow) TableName() string {
+	return "t_subject_workflow"
+}
+
+func init() {
+	Register(&SubjectWorkflow{})
+}
+
+// PolicyRule is a policy rule kept in the table, it is loaded together with the rules in config
+type PolicyRule struct {
+	ID       int32  `gorm:"primary_key"`
+	TenantID string `gorm:"column:tenant_id"`
+	Name     string `gorm:"column:name"`
+	Expr     string `gorm:"column:expr"`
+	Action   string `gorm:"column:action"` // approve|refuse|workflow
+	Workflow string `gorm:"column:workflow"`
+	Priority int32  `gorm:"column:priority"`
+	Enabled  bool   `gorm:"column:enabled"`
+}
+
+// TableName is the getter for tables' names
+func (c *PolicyRule) TableName() string {
+	return "t_policy_rule"
+}
+
+func init() {
+	Register(&PolicyRule{})
+}
+
+// AuditLog records a change made to an apply
+type AuditLog struct {
+	ID        int32     `gorm:"primary_key"`
+	TenantID  string    `gorm:"column:tenant_id"`
+	BatchID   string    `gorm:"column:batch_id"` // groups the entries of one import
+	Actor     string    `gorm:"column:actor"`
+	Action    string    `gorm:"column:action"`
+	ApplyID   int32     `gorm:"column:apply_id"`
+	Detail    string    `gorm:"column:detail"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// TableName is the getter for tables' names
+func (c *AuditLog) TableName() string {
+	return "t_audit_log"
+}
+
+func init() {
+	Register(&AuditLog{})
+}
+
+// IdempotencyKey keeps the response of a mutating request, keyed by the client supplied idempotency key
+// of the caller, so a key is only replayed to the caller that sent it
+type IdempotencyKey struct {
+	TenantID    string    `gorm:"column:tenant_id;primary_key"`
+	Uid         string    `gorm:"column:uid;primary_key"` // empty for the anonymous callers
+	Key         string    `gorm:"column:idem_key;primary_key"`
+	Method      string    `gorm:"column:method"`
+	Fingerprint string    `gorm:"column:fingerprint"` // sha256 of method and request body
+	RespType    string    `gorm:"column:resp_type"`   // empty while the request is in progress
+	Response    []byte    `gorm:"column:response"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+	ExpiresAt   time.Time `gorm:"column:expires_at"`
+}
+
+// TableName is the getter for tables' names
+func (c *IdempotencyKey) TableName() string {
+	return "t_idempotency_key"
+}
+
+func init() {
+	Register(&IdempotencyKey{})
+}
+
+// splitList splits a comma separated column, skipping empty items
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Notification records a reminder sent for an apply, its key makes sure a reminder is sent once
+// across restarts and replicas
+type Notification struct {
+	Key       string    `gorm:"column:notify_key;primary_key"` // apply id, window and expiry, see NotificationKey
+	TenantID  string    `gorm:"column:tenant_id"`
+	ApplyID   int32     `gorm:"column:apply_id"`
+	Applyer   string    `gorm:"column:applyer"`
+	Window    int64     `gorm:"column:window_sec"`
+	ExpiresAt time.Time `gorm:"column:expires_at"` // expiry of the apply when the reminder was sent
+	Status    string    `gorm:"column:status"`     // sending|sent
+	Error     string    `gorm:"column:error"`      // channels that failed while others succeeded
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// TableName is the getter for tables' names
+func (c *Notification) TableName() string {
+	return "t_notification"
+}
+
+func init() {
+	Register(&Notification{})
+}
+
+// ApplyStat is a row of the summary of the applies, refreshed in the background. It counts the
+// applies created on a day with the same status, subject, applyer and reviewer.
+type ApplyStat struct {
+	ID          int32     `gorm:"primary_key"`
+	TenantID    string    `gorm:"column:tenant_id"`
+	Day         string    `gorm:"column:day"` // creation day in the server timezone, 2006-01-02
+	Status      string    `gorm:"column:status"`
+	SubjectName string    `gorm:"column:subject_name"`
+	Applyer     string    `gorm:"column:applyer"`
+	Reviewer    string    `gorm:"column:reviewer"`
+	Total       int64     `gorm:"column:total"`
+	Active      int64     `gorm:"column:active"`       // approved and unexpired at RefreshedAt
+	Reviewed    int64     `gorm:"column:reviewed"`     // with a review time, the applies the latencies are taken from
+	LatencyHist string    `gorm:"column:latency_hist"` // comma separated counts of the review latencies, see stats.Bounds
+	RefreshedAt time.Time `gorm:"column:refreshed_at"`
+}
+
+// TableName is the getter for tables' names
+func (c *ApplyStat) TableName() string {
+	return "t_apply_stat"
+}
+
+func init() {
+	Register(&ApplyStat{})
+}